@@ -1,5 +1,7 @@
 package apiproxy
 
+//go:generate go run github.com/SSHcom/privx-sdk-go/v2/internal/mockgen
+
 import (
 	"net/url"
 
@@ -48,8 +50,8 @@ func (c *ApiProxy) GetApiTargets(opts ...filters.Option) (*response.ResultSet[Ap
 	apiTargets := &response.ResultSet[ApiTarget]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -65,8 +67,8 @@ func (c *ApiProxy) SearchApiTargets(search *ApiTargetSearchRequest, opts ...filt
 	apiTargets := &response.ResultSet[ApiTarget]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -82,8 +84,8 @@ func (c *ApiProxy) GetApiTargetTags(opts ...filters.Option) (*response.ResultSet
 	tags := &response.ResultSet[string]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -96,6 +98,10 @@ func (c *ApiProxy) GetApiTargetTags(opts ...filters.Option) (*response.ResultSet
 
 // CreateApiTarget create a api target.
 func (c *ApiProxy) CreateApiTarget(apiTarget *ApiTarget) (response.Identifier, error) {
+	if err := apiTarget.Validate(); err != nil {
+		return response.Identifier{}, err
+	}
+
 	identifier := response.Identifier{}
 
 	_, err := c.api.
@@ -118,6 +124,10 @@ func (c *ApiProxy) GetApiTarget(apiTargetID string) (*ApiTarget, error) {
 
 // UpdateApiTarget update api target by id.
 func (c *ApiProxy) UpdateApiTarget(apiTargetID string, apiTarget *ApiTarget) error {
+	if err := apiTarget.Validate(); err != nil {
+		return err
+	}
+
 	_, err := c.api.
 		URL("/api-proxy/api/v1/api-targets/%s", apiTargetID).
 		Put(&apiTarget)
@@ -140,8 +150,8 @@ func (c *ApiProxy) GetCurrentUserClientCredentials(opts ...filters.Option) (*res
 	creds := &response.ResultSet[ClientCredential]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -154,6 +164,10 @@ func (c *ApiProxy) GetCurrentUserClientCredentials(opts ...filters.Option) (*res
 
 // CreateCurrentUserClientCredential create client crendetial for current user.
 func (c *ApiProxy) CreateCurrentUserClientCredential(creds *ClientCredential) (response.Identifier, error) {
+	if err := creds.Validate(); err != nil {
+		return response.Identifier{}, err
+	}
+
 	identifier := response.Identifier{}
 
 	_, err := c.api.
@@ -180,8 +194,8 @@ func (c *ApiProxy) GetCurrentUserClientCredential(credID string) (*ClientCredent
 func (c *ApiProxy) GetCurrentUserClientCredentialSecret(credID string, opts ...filters.Option) ([]byte, error) {
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	secret, err := c.api.
@@ -194,6 +208,10 @@ func (c *ApiProxy) GetCurrentUserClientCredentialSecret(credID string, opts ...f
 
 // UpdateCurrentUserClientCredential update current user client credential by credential id.
 func (c *ApiProxy) UpdateCurrentUserClientCredential(credID string, cred *ClientCredential) error {
+	if err := cred.Validate(); err != nil {
+		return err
+	}
+
 	_, err := c.api.
 		URL("/api-proxy/api/v1/users/current/client-credentials/%s", credID).
 		Put(&cred)
@@ -216,8 +234,8 @@ func (c *ApiProxy) GetUserClientCredentials(userID string, opts ...filters.Optio
 	creds := &response.ResultSet[ClientCredential]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -230,6 +248,10 @@ func (c *ApiProxy) GetUserClientCredentials(userID string, opts ...filters.Optio
 
 // CreateUserClientCredential create client crendetial for user by user id.
 func (c *ApiProxy) CreateUserClientCredential(userID string, creds *ClientCredential) (response.Identifier, error) {
+	if err := creds.Validate(); err != nil {
+		return response.Identifier{}, err
+	}
+
 	identifier := response.Identifier{}
 
 	_, err := c.api.
@@ -256,8 +278,8 @@ func (c *ApiProxy) GetUserClientCredential(userID, credID string) (*ClientCreden
 func (c *ApiProxy) GetUserClientCredentialSecret(userID, credID string, opts ...filters.Option) ([]byte, error) {
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	secret, err := c.api.
@@ -270,6 +292,10 @@ func (c *ApiProxy) GetUserClientCredentialSecret(userID, credID string, opts ...
 
 // UpdateUserClientCredential update user client credential by credential and user id.
 func (c *ApiProxy) UpdateUserClientCredential(userID, credID string, cred *ClientCredential) error {
+	if err := cred.Validate(); err != nil {
+		return err
+	}
+
 	_, err := c.api.
 		URL("/api-proxy/api/v1/users/%s/client-credentials/%s", userID, credID).
 		Put(&cred)
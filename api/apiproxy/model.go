@@ -1,5 +1,38 @@
 package apiproxy
 
+import "github.com/SSHcom/privx-sdk-go/v2/api/response"
+
+const (
+	// Enumerated values for api target disabled status.
+	NotDisabled       DisabledStatus = "NOT_DISABLED"
+	DisabledByAdmin   DisabledStatus = "BY_ADMIN"
+	DisabledByLicense DisabledStatus = "BY_LICENSE"
+)
+
+// DisabledStatus definition for reasons of api target being disabled.
+type DisabledStatus string
+
+const (
+	// Enumerated values for api target and client credential types.
+	CredentialBasicAuth            CredentialType = "basicauth"
+	CredentialToken                CredentialType = "token"
+	CredentialCertificate          CredentialType = "certificate"
+	CredentialEphemeralCertificate CredentialType = "ephemeral-certificate"
+)
+
+// CredentialType definition for api target and client credential types.
+type CredentialType string
+
+const (
+	// Enumerated values for PrivX login session requirement.
+	LoginSessionDefault  LoginSessionRequirement = "DEFAULT"
+	LoginSessionEnabled  LoginSessionRequirement = "ENABLED"
+	LoginSessionDisabled LoginSessionRequirement = "DISABLED"
+)
+
+// LoginSessionRequirement definition for PrivX login session requirement of client credentials.
+type LoginSessionRequirement string
+
 // ApiTarget object specifies all information necessary for performing access
 // control for requests to an API target
 type ApiTarget struct {
@@ -47,22 +80,22 @@ type ApiTarget struct {
 	// Disabled specifies whether this api target is enabled or not. All request
 	// to disabled api targets are rejected.
 	// Can be one of NOT_DISABLED, BY_ADMIN, BY_LICENSE.
-	Disabled string `json:"disabled"`
+	Disabled DisabledStatus `json:"disabled"`
 
 	// AuditEnabled specifies whether to session record requests to this target api.
 	AuditEnabled bool `json:"audit_enabled,omitempty"`
 
-	Created   string `json:"created"`
-	Author    string `json:"author"`
-	Updated   string `json:"updated,omitempty"`
-	UpdatedBy string `json:"updated_by,omitempty"`
+	Created   response.Timestamp `json:"created"`
+	Author    string             `json:"author"`
+	Updated   response.Timestamp `json:"updated,omitzero"`
+	UpdatedBy string             `json:"updated_by,omitempty"`
 }
 
 // TargetCredential object contains the credentials for authenticating to the api target.
 type TargetCredential struct {
 	// Type is the api target credential type. Accepted values are "basicauth",
 	// "token", "certificate" and "ephemeral-certificate".
-	Type string `json:"type"`
+	Type CredentialType `json:"type"`
 
 	// BasicAuthUsername is the username for credentials of type "basicauth"
 	BasicAuthUsername string `json:"basic_auth_username,omitempty"`
@@ -128,13 +161,13 @@ type RoleHandle struct {
 
 // ApiProxyCACertificateInfo api proxy x509 CA certificate information
 type ApiProxyCACertificateInfo struct {
-	Subject           string `json:"subject,omitempty"`
-	Issuer            string `json:"issuer,omitempty"`
-	Serial            string `json:"serial,omitempty"`
-	NotBefore         string `json:"not_before,omitempty"`
-	NotAfter          string `json:"not_after,omitempty"`
-	FingerPrintSHA1   string `json:"fingerprint_sha1,omitempty"`
-	FingerPrintSHA256 string `json:"fingerprint_sha256,omitempty"`
+	Subject           string             `json:"subject,omitempty"`
+	Issuer            string             `json:"issuer,omitempty"`
+	Serial            string             `json:"serial,omitempty"`
+	NotBefore         response.Timestamp `json:"not_before,omitzero"`
+	NotAfter          response.Timestamp `json:"not_after,omitzero"`
+	FingerPrintSHA1   string             `json:"fingerprint_sha1,omitempty"`
+	FingerPrintSHA256 string             `json:"fingerprint_sha256,omitempty"`
 }
 
 // ApiProxyAPIConf response for GetApiProxyConfig()
@@ -170,10 +203,10 @@ type ClientCredential struct {
 	Target ApiTargetHandle `json:"target"`
 
 	// NotBefore specifies the start of the client credential validity period.
-	NotBefore string `json:"not_before"`
+	NotBefore response.Timestamp `json:"not_before"`
 
 	// NotAfter specifies the end of the client credential validity period.
-	NotAfter string `json:"not_after"`
+	NotAfter response.Timestamp `json:"not_after"`
 
 	// SourceAddress specifies optional IP address and/or CIDRs from which
 	// requests using this client credential are allowed.
@@ -184,7 +217,7 @@ type ClientCredential struct {
 	// to DEFAULT, the ApiProxyInternal.require_privx_login_session setting
 	// decides the behavior.
 	// Accepted values are "DEFAULT", "ENABLED" and "DISABLED".
-	PrivxLoginSessionRequired string `json:"privx_login_session_required,omitempty"`
+	PrivxLoginSessionRequired LoginSessionRequirement `json:"privx_login_session_required,omitempty"`
 
 	// Enabled specifies whether this client credential is enabled or not. All
 	// requests using disabled client credentials are rejected.
@@ -194,7 +227,7 @@ type ClientCredential struct {
 	// If credential type is passed as empty string, backend will automatically select the credential type based on
 	// the api-target the credential is associated with. If credential.type is specified, backend verifies the type
 	// is compatible with the api-target.
-	Type string `json:"type"`
+	Type CredentialType `json:"type"`
 
 	// BasicAuthUsername is the username for client credentials of type
 	// "basicauth"
@@ -224,12 +257,12 @@ type ClientCredential struct {
 
 	// LastUsed is the approximate timestamp of when client credential was last
 	// used
-	LastUsed string `json:"last_used,omitempty"`
+	LastUsed response.Timestamp `json:"last_used,omitzero"`
 
-	Created   string `json:"created"`
-	Author    string `json:"author"`
-	Updated   string `json:"updated,omitempty"`
-	UpdatedBy string `json:"updated_by,omitempty"`
+	Created   response.Timestamp `json:"created"`
+	Author    string             `json:"author"`
+	Updated   response.Timestamp `json:"updated,omitzero"`
+	UpdatedBy string             `json:"updated_by,omitempty"`
 }
 
 type ApiTargetHandle struct {
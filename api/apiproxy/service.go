@@ -0,0 +1,275 @@
+// Code generated by mockgen from ApiProxy method set; DO NOT EDIT.
+
+package apiproxy
+
+import (
+	"errors"
+
+	"github.com/SSHcom/privx-sdk-go/v2/api/filters"
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
+)
+
+// Service is the interface of ApiProxy client, it allows substitution of
+// the client in tests, see Fake.
+type Service interface {
+	// CreateApiTarget create a api target.
+	CreateApiTarget(apiTarget *ApiTarget) (response.Identifier, error)
+	// CreateCurrentUserClientCredential create client crendetial for current user.
+	CreateCurrentUserClientCredential(creds *ClientCredential) (response.Identifier, error)
+	// CreateUserClientCredential create client crendetial for user by user id.
+	CreateUserClientCredential(userID string, creds *ClientCredential) (response.Identifier, error)
+	// DeleteApiTarget delete api target by id.
+	DeleteApiTarget(apiTargetID string) error
+	// DeleteCurrentUserClientCredential delete current user client credential by credential id.
+	DeleteCurrentUserClientCredential(credID string) error
+	// DeleteUserClientCredential delete user client credential by credential and user id.
+	DeleteUserClientCredential(userID string, credID string) error
+	// GetApiProxyConfig get api proxy config.
+	GetApiProxyConfig() (*ApiProxyAPIConf, error)
+	// GetApiTarget get api target by id.
+	GetApiTarget(apiTargetID string) (*ApiTarget, error)
+	// GetApiTargetTags get api target tags.
+	GetApiTargetTags(opts ...filters.Option) (*response.ResultSet[string], error)
+	// GetApiTargets get api targets.
+	GetApiTargets(opts ...filters.Option) (*response.ResultSet[ApiTarget], error)
+	// GetCurrentUserClientCredential get current users client credential by credential id.
+	GetCurrentUserClientCredential(credID string) (*ClientCredential, error)
+	// GetCurrentUserClientCredentialSecret get current users client credential secret by credential id.
+	// Query parameter "format" (return the secret in specific format) supports the values
+	// "raw" and "kubeconfig".
+	GetCurrentUserClientCredentialSecret(credID string, opts ...filters.Option) ([]byte, error)
+	// GetCurrentUserClientCredentials get current users client credentials.
+	GetCurrentUserClientCredentials(opts ...filters.Option) (*response.ResultSet[ClientCredential], error)
+	// GetUserClientCredential get users client credential by credential and user id.
+	GetUserClientCredential(userID string, credID string) (*ClientCredential, error)
+	// GetUserClientCredentialSecret get users client credential secret by credential and user id.
+	// Query parameter "format" (return the secret in specific format) supports the values
+	// "raw" and "kubeconfig".
+	GetUserClientCredentialSecret(userID string, credID string, opts ...filters.Option) ([]byte, error)
+	// GetUserClientCredentials get users client credentials by user id.
+	GetUserClientCredentials(userID string, opts ...filters.Option) (*response.ResultSet[ClientCredential], error)
+	// SearchApiTargets search api targets.
+	SearchApiTargets(search *ApiTargetSearchRequest, opts ...filters.Option) (*response.ResultSet[ApiTarget], error)
+	// Status get api proxy microservice status.
+	Status() (*response.ServiceStatus, error)
+	// UpdateApiTarget update api target by id.
+	UpdateApiTarget(apiTargetID string, apiTarget *ApiTarget) error
+	// UpdateCurrentUserClientCredential update current user client credential by credential id.
+	UpdateCurrentUserClientCredential(credID string, cred *ClientCredential) error
+	// UpdateUserClientCredential update user client credential by credential and user id.
+	UpdateUserClientCredential(userID string, credID string, cred *ClientCredential) error
+}
+
+var (
+	_ Service = (*ApiProxy)(nil)
+	_ Service = (*Fake)(nil)
+)
+
+// Fake is a fake of ApiProxy client. Methods delegate to the corresponding
+// function fields, undefined functions return an error.
+type Fake struct {
+	CreateApiTargetFunc                      func(*ApiTarget) (response.Identifier, error)
+	CreateCurrentUserClientCredentialFunc    func(*ClientCredential) (response.Identifier, error)
+	CreateUserClientCredentialFunc           func(string, *ClientCredential) (response.Identifier, error)
+	DeleteApiTargetFunc                      func(string) error
+	DeleteCurrentUserClientCredentialFunc    func(string) error
+	DeleteUserClientCredentialFunc           func(string, string) error
+	GetApiProxyConfigFunc                    func() (*ApiProxyAPIConf, error)
+	GetApiTargetFunc                         func(string) (*ApiTarget, error)
+	GetApiTargetTagsFunc                     func(...filters.Option) (*response.ResultSet[string], error)
+	GetApiTargetsFunc                        func(...filters.Option) (*response.ResultSet[ApiTarget], error)
+	GetCurrentUserClientCredentialFunc       func(string) (*ClientCredential, error)
+	GetCurrentUserClientCredentialSecretFunc func(string, ...filters.Option) ([]byte, error)
+	GetCurrentUserClientCredentialsFunc      func(...filters.Option) (*response.ResultSet[ClientCredential], error)
+	GetUserClientCredentialFunc              func(string, string) (*ClientCredential, error)
+	GetUserClientCredentialSecretFunc        func(string, string, ...filters.Option) ([]byte, error)
+	GetUserClientCredentialsFunc             func(string, ...filters.Option) (*response.ResultSet[ClientCredential], error)
+	SearchApiTargetsFunc                     func(*ApiTargetSearchRequest, ...filters.Option) (*response.ResultSet[ApiTarget], error)
+	StatusFunc                               func() (*response.ServiceStatus, error)
+	UpdateApiTargetFunc                      func(string, *ApiTarget) error
+	UpdateCurrentUserClientCredentialFunc    func(string, *ClientCredential) error
+	UpdateUserClientCredentialFunc           func(string, string, *ClientCredential) error
+}
+
+// CreateApiTarget calls CreateApiTargetFunc.
+func (f *Fake) CreateApiTarget(apiTarget *ApiTarget) (response.Identifier, error) {
+	if f.CreateApiTargetFunc == nil {
+		var r0 response.Identifier
+		return r0, errors.New("apiproxy: Fake.CreateApiTarget is not implemented")
+	}
+	return f.CreateApiTargetFunc(apiTarget)
+}
+
+// CreateCurrentUserClientCredential calls CreateCurrentUserClientCredentialFunc.
+func (f *Fake) CreateCurrentUserClientCredential(creds *ClientCredential) (response.Identifier, error) {
+	if f.CreateCurrentUserClientCredentialFunc == nil {
+		var r0 response.Identifier
+		return r0, errors.New("apiproxy: Fake.CreateCurrentUserClientCredential is not implemented")
+	}
+	return f.CreateCurrentUserClientCredentialFunc(creds)
+}
+
+// CreateUserClientCredential calls CreateUserClientCredentialFunc.
+func (f *Fake) CreateUserClientCredential(userID string, creds *ClientCredential) (response.Identifier, error) {
+	if f.CreateUserClientCredentialFunc == nil {
+		var r0 response.Identifier
+		return r0, errors.New("apiproxy: Fake.CreateUserClientCredential is not implemented")
+	}
+	return f.CreateUserClientCredentialFunc(userID, creds)
+}
+
+// DeleteApiTarget calls DeleteApiTargetFunc.
+func (f *Fake) DeleteApiTarget(apiTargetID string) error {
+	if f.DeleteApiTargetFunc == nil {
+		return errors.New("apiproxy: Fake.DeleteApiTarget is not implemented")
+	}
+	return f.DeleteApiTargetFunc(apiTargetID)
+}
+
+// DeleteCurrentUserClientCredential calls DeleteCurrentUserClientCredentialFunc.
+func (f *Fake) DeleteCurrentUserClientCredential(credID string) error {
+	if f.DeleteCurrentUserClientCredentialFunc == nil {
+		return errors.New("apiproxy: Fake.DeleteCurrentUserClientCredential is not implemented")
+	}
+	return f.DeleteCurrentUserClientCredentialFunc(credID)
+}
+
+// DeleteUserClientCredential calls DeleteUserClientCredentialFunc.
+func (f *Fake) DeleteUserClientCredential(userID string, credID string) error {
+	if f.DeleteUserClientCredentialFunc == nil {
+		return errors.New("apiproxy: Fake.DeleteUserClientCredential is not implemented")
+	}
+	return f.DeleteUserClientCredentialFunc(userID, credID)
+}
+
+// GetApiProxyConfig calls GetApiProxyConfigFunc.
+func (f *Fake) GetApiProxyConfig() (*ApiProxyAPIConf, error) {
+	if f.GetApiProxyConfigFunc == nil {
+		var r0 *ApiProxyAPIConf
+		return r0, errors.New("apiproxy: Fake.GetApiProxyConfig is not implemented")
+	}
+	return f.GetApiProxyConfigFunc()
+}
+
+// GetApiTarget calls GetApiTargetFunc.
+func (f *Fake) GetApiTarget(apiTargetID string) (*ApiTarget, error) {
+	if f.GetApiTargetFunc == nil {
+		var r0 *ApiTarget
+		return r0, errors.New("apiproxy: Fake.GetApiTarget is not implemented")
+	}
+	return f.GetApiTargetFunc(apiTargetID)
+}
+
+// GetApiTargetTags calls GetApiTargetTagsFunc.
+func (f *Fake) GetApiTargetTags(opts ...filters.Option) (*response.ResultSet[string], error) {
+	if f.GetApiTargetTagsFunc == nil {
+		var r0 *response.ResultSet[string]
+		return r0, errors.New("apiproxy: Fake.GetApiTargetTags is not implemented")
+	}
+	return f.GetApiTargetTagsFunc(opts...)
+}
+
+// GetApiTargets calls GetApiTargetsFunc.
+func (f *Fake) GetApiTargets(opts ...filters.Option) (*response.ResultSet[ApiTarget], error) {
+	if f.GetApiTargetsFunc == nil {
+		var r0 *response.ResultSet[ApiTarget]
+		return r0, errors.New("apiproxy: Fake.GetApiTargets is not implemented")
+	}
+	return f.GetApiTargetsFunc(opts...)
+}
+
+// GetCurrentUserClientCredential calls GetCurrentUserClientCredentialFunc.
+func (f *Fake) GetCurrentUserClientCredential(credID string) (*ClientCredential, error) {
+	if f.GetCurrentUserClientCredentialFunc == nil {
+		var r0 *ClientCredential
+		return r0, errors.New("apiproxy: Fake.GetCurrentUserClientCredential is not implemented")
+	}
+	return f.GetCurrentUserClientCredentialFunc(credID)
+}
+
+// GetCurrentUserClientCredentialSecret calls GetCurrentUserClientCredentialSecretFunc.
+func (f *Fake) GetCurrentUserClientCredentialSecret(credID string, opts ...filters.Option) ([]byte, error) {
+	if f.GetCurrentUserClientCredentialSecretFunc == nil {
+		var r0 []byte
+		return r0, errors.New("apiproxy: Fake.GetCurrentUserClientCredentialSecret is not implemented")
+	}
+	return f.GetCurrentUserClientCredentialSecretFunc(credID, opts...)
+}
+
+// GetCurrentUserClientCredentials calls GetCurrentUserClientCredentialsFunc.
+func (f *Fake) GetCurrentUserClientCredentials(opts ...filters.Option) (*response.ResultSet[ClientCredential], error) {
+	if f.GetCurrentUserClientCredentialsFunc == nil {
+		var r0 *response.ResultSet[ClientCredential]
+		return r0, errors.New("apiproxy: Fake.GetCurrentUserClientCredentials is not implemented")
+	}
+	return f.GetCurrentUserClientCredentialsFunc(opts...)
+}
+
+// GetUserClientCredential calls GetUserClientCredentialFunc.
+func (f *Fake) GetUserClientCredential(userID string, credID string) (*ClientCredential, error) {
+	if f.GetUserClientCredentialFunc == nil {
+		var r0 *ClientCredential
+		return r0, errors.New("apiproxy: Fake.GetUserClientCredential is not implemented")
+	}
+	return f.GetUserClientCredentialFunc(userID, credID)
+}
+
+// GetUserClientCredentialSecret calls GetUserClientCredentialSecretFunc.
+func (f *Fake) GetUserClientCredentialSecret(userID string, credID string, opts ...filters.Option) ([]byte, error) {
+	if f.GetUserClientCredentialSecretFunc == nil {
+		var r0 []byte
+		return r0, errors.New("apiproxy: Fake.GetUserClientCredentialSecret is not implemented")
+	}
+	return f.GetUserClientCredentialSecretFunc(userID, credID, opts...)
+}
+
+// GetUserClientCredentials calls GetUserClientCredentialsFunc.
+func (f *Fake) GetUserClientCredentials(userID string, opts ...filters.Option) (*response.ResultSet[ClientCredential], error) {
+	if f.GetUserClientCredentialsFunc == nil {
+		var r0 *response.ResultSet[ClientCredential]
+		return r0, errors.New("apiproxy: Fake.GetUserClientCredentials is not implemented")
+	}
+	return f.GetUserClientCredentialsFunc(userID, opts...)
+}
+
+// SearchApiTargets calls SearchApiTargetsFunc.
+func (f *Fake) SearchApiTargets(search *ApiTargetSearchRequest, opts ...filters.Option) (*response.ResultSet[ApiTarget], error) {
+	if f.SearchApiTargetsFunc == nil {
+		var r0 *response.ResultSet[ApiTarget]
+		return r0, errors.New("apiproxy: Fake.SearchApiTargets is not implemented")
+	}
+	return f.SearchApiTargetsFunc(search, opts...)
+}
+
+// Status calls StatusFunc.
+func (f *Fake) Status() (*response.ServiceStatus, error) {
+	if f.StatusFunc == nil {
+		var r0 *response.ServiceStatus
+		return r0, errors.New("apiproxy: Fake.Status is not implemented")
+	}
+	return f.StatusFunc()
+}
+
+// UpdateApiTarget calls UpdateApiTargetFunc.
+func (f *Fake) UpdateApiTarget(apiTargetID string, apiTarget *ApiTarget) error {
+	if f.UpdateApiTargetFunc == nil {
+		return errors.New("apiproxy: Fake.UpdateApiTarget is not implemented")
+	}
+	return f.UpdateApiTargetFunc(apiTargetID, apiTarget)
+}
+
+// UpdateCurrentUserClientCredential calls UpdateCurrentUserClientCredentialFunc.
+func (f *Fake) UpdateCurrentUserClientCredential(credID string, cred *ClientCredential) error {
+	if f.UpdateCurrentUserClientCredentialFunc == nil {
+		return errors.New("apiproxy: Fake.UpdateCurrentUserClientCredential is not implemented")
+	}
+	return f.UpdateCurrentUserClientCredentialFunc(credID, cred)
+}
+
+// UpdateUserClientCredential calls UpdateUserClientCredentialFunc.
+func (f *Fake) UpdateUserClientCredential(userID string, credID string, cred *ClientCredential) error {
+	if f.UpdateUserClientCredentialFunc == nil {
+		return errors.New("apiproxy: Fake.UpdateUserClientCredential is not implemented")
+	}
+	return f.UpdateUserClientCredentialFunc(userID, credID, cred)
+}
@@ -0,0 +1,91 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package apiproxy
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Validate checks required fields and enumerated values of api target.
+func (t *ApiTarget) Validate() error {
+	if strings.TrimSpace(t.Name) == "" {
+		return fmt.Errorf("invalid api target: name is required")
+	}
+
+	for _, tag := range t.Tags {
+		if strings.TrimSpace(tag) == "" {
+			return fmt.Errorf("invalid api target: empty tag")
+		}
+	}
+
+	switch t.Disabled {
+	case "", NotDisabled, DisabledByAdmin, DisabledByLicense:
+	default:
+		return fmt.Errorf("invalid api target: unknown disabled status %q", t.Disabled)
+	}
+
+	switch t.TargetCredential.Type {
+	case CredentialBasicAuth, CredentialToken, CredentialCertificate, CredentialEphemeralCertificate:
+	case "":
+		return fmt.Errorf("invalid api target: target credential type is required")
+	default:
+		return fmt.Errorf("invalid api target: unknown target credential type %q", t.TargetCredential.Type)
+	}
+
+	for _, endpoint := range append(t.AuthorizedEndpoints, t.UnauthorizedEndpoints...) {
+		if err := endpoint.Validate(); err != nil {
+			return fmt.Errorf("invalid api target: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Validate checks protocols and methods of api target endpoint.
+func (e *ApiTargetEndpoint) Validate() error {
+	for _, protocol := range e.Protocols {
+		switch protocol {
+		case "http", "https", "*":
+		default:
+			return fmt.Errorf("endpoint %s: unknown protocol %q", e.Host, protocol)
+		}
+	}
+
+	for _, method := range e.Methods {
+		switch method {
+		case "GET", "PUT", "POST", "DELETE", "HEAD", "PATCH", "OPTIONS", "TRACE", "*":
+		default:
+			return fmt.Errorf("endpoint %s: unknown method %q", e.Host, method)
+		}
+	}
+
+	return nil
+}
+
+// Validate checks required fields and enumerated values of client credential.
+// Empty credential type is accepted, the backend selects the type based on
+// the api target.
+func (c *ClientCredential) Validate() error {
+	if c.Target.ID == "" {
+		return fmt.Errorf("invalid client credential: target id is required")
+	}
+
+	switch c.Type {
+	case "", CredentialBasicAuth, CredentialToken, CredentialCertificate:
+	default:
+		return fmt.Errorf("invalid client credential: unknown type %q", c.Type)
+	}
+
+	switch c.PrivxLoginSessionRequired {
+	case "", LoginSessionDefault, LoginSessionEnabled, LoginSessionDisabled:
+	default:
+		return fmt.Errorf("invalid client credential: unknown login session requirement %q", c.PrivxLoginSessionRequired)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package apiproxy
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestApiTargetValidate(t *testing.T) {
+	valid := ApiTarget{
+		Name:             "target",
+		Disabled:         DisabledByAdmin,
+		TargetCredential: TargetCredential{Type: CredentialToken},
+		AuthorizedEndpoints: []ApiTargetEndpoint{
+			{Host: "example.com", Protocols: []string{"https"}, Methods: []string{"GET"}},
+		},
+	}
+	if err := valid.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, mutate := range map[string]func(*ApiTarget){
+		"name":       func(t *ApiTarget) { t.Name = " " },
+		"disabled":   func(t *ApiTarget) { t.Disabled = "YES" },
+		"credential": func(t *ApiTarget) { t.TargetCredential.Type = "" },
+		"protocol":   func(t *ApiTarget) { t.AuthorizedEndpoints[0].Protocols = []string{"ftp"} },
+	} {
+		target := valid
+		target.AuthorizedEndpoints = []ApiTargetEndpoint{valid.AuthorizedEndpoints[0]}
+		mutate(&target)
+		if err := target.Validate(); err == nil {
+			t.Errorf("invalid %s is accepted", name)
+		}
+	}
+}
+
+func TestClientCredentialValidate(t *testing.T) {
+	var cred ClientCredential
+	if err := json.Unmarshal(
+		[]byte(`{"target": {"id": "t"}, "type": "basicauth", "privx_login_session_required": "ENABLED"}`),
+		&cred,
+	); err != nil {
+		t.Fatal(err)
+	}
+	if err := cred.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	cred.Type = CredentialEphemeralCertificate
+	if err := cred.Validate(); err == nil {
+		t.Error("ephemeral certificate client credential is accepted")
+	}
+}
@@ -6,6 +6,8 @@
 
 package auth
 
+//go:generate go run github.com/SSHcom/privx-sdk-go/v2/internal/mockgen
+
 import (
 	"net/url"
 
@@ -95,8 +97,8 @@ func (c *Auth) GetUserSessions(userID string, opts ...filters.Option) (*response
 	userSessions := &response.ResultSet[Session]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -112,8 +114,8 @@ func (c *Auth) GetSourceSessions(sourceID string, opts ...filters.Option) (*resp
 	sourceSessions := &response.ResultSet[Session]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -129,8 +131,8 @@ func (c *Auth) SearchSessions(search *SessionSearch, opts ...filters.Option) (*r
 	sessions := &response.ResultSet[Session]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
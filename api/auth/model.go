@@ -6,7 +6,11 @@
 
 package auth
 
-import "time"
+import (
+	"time"
+
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
+)
 
 // IdpClient identity provider client definition.
 type IdpClient struct {
@@ -84,10 +88,10 @@ type SessionSearch struct {
 
 // Device paired mobile gateway device definition.
 type Device struct {
-	ID        string `json:"id"`
-	OS        string `json:"os"`
-	Name      string `json:"name"`
-	Activated string `json:"activated"`
-	Updated   string `json:"updated"`
-	LastUsed  string `json:"lastUsed"`
+	ID        string             `json:"id"`
+	OS        string             `json:"os"`
+	Name      string             `json:"name"`
+	Activated string             `json:"activated"`
+	Updated   response.Timestamp `json:"updated"`
+	LastUsed  response.Timestamp `json:"lastUsed"`
 }
@@ -0,0 +1,188 @@
+// Code generated by mockgen from Auth method set; DO NOT EDIT.
+
+package auth
+
+import (
+	"errors"
+
+	"github.com/SSHcom/privx-sdk-go/v2/api/filters"
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
+)
+
+// Service is the interface of Auth client, it allows substitution of
+// the client in tests, see Fake.
+type Service interface {
+	// CreateIdpClient creates a new identity provider client configuration.
+	CreateIdpClient(idpClient *IdpClient) (response.Identifier, error)
+	// DeleteIdpClient delete identity provider client configuration by id.
+	DeleteIdpClient(idpID string) error
+	// GetIdpClient get existing identity provider client configuration.
+	GetIdpClient(idpID string) (*IdpClient, error)
+	// GetSourceSessions get valid sessions by sourceID.
+	GetSourceSessions(sourceID string, opts ...filters.Option) (*response.ResultSet[Session], error)
+	// GetUserPairedDevices get users paired devices.
+	GetUserPairedDevices(userID string) (*response.ResultSet[Device], error)
+	// GetUserSessions get valid sessions by userID.
+	GetUserSessions(userID string, opts ...filters.Option) (*response.ResultSet[Session], error)
+	// Logout log out user.
+	Logout() error
+	// RegenerateIdpClientConfig regenerates client_id and client_secret
+	// for OIDC identity provider client configuration.
+	RegenerateIdpClientConfig(idpID string) (*IdpClientConfig, error)
+	// SearchSessions searches for sessions
+	SearchSessions(search *SessionSearch, opts ...filters.Option) (*response.ResultSet[Session], error)
+	// Status get auth microservice status.
+	Status() (*response.ServiceStatus, error)
+	// TerminateSession terminates single session by id.
+	TerminateSession(sessionID string) error
+	// TerminateUserSessions terminates all sessions for a user.
+	TerminateUserSessions(userID string) error
+	// UnpairUserDevice unpair users device.
+	UnpairUserDevice(userID string, deviceID string) error
+	// UpdateIdpClient updates existing identity provider client configuration definition.
+	UpdateIdpClient(idpClient *IdpClient, idpID string) error
+}
+
+var (
+	_ Service = (*Auth)(nil)
+	_ Service = (*Fake)(nil)
+)
+
+// Fake is a fake of Auth client. Methods delegate to the corresponding
+// function fields, undefined functions return an error.
+type Fake struct {
+	CreateIdpClientFunc           func(*IdpClient) (response.Identifier, error)
+	DeleteIdpClientFunc           func(string) error
+	GetIdpClientFunc              func(string) (*IdpClient, error)
+	GetSourceSessionsFunc         func(string, ...filters.Option) (*response.ResultSet[Session], error)
+	GetUserPairedDevicesFunc      func(string) (*response.ResultSet[Device], error)
+	GetUserSessionsFunc           func(string, ...filters.Option) (*response.ResultSet[Session], error)
+	LogoutFunc                    func() error
+	RegenerateIdpClientConfigFunc func(string) (*IdpClientConfig, error)
+	SearchSessionsFunc            func(*SessionSearch, ...filters.Option) (*response.ResultSet[Session], error)
+	StatusFunc                    func() (*response.ServiceStatus, error)
+	TerminateSessionFunc          func(string) error
+	TerminateUserSessionsFunc     func(string) error
+	UnpairUserDeviceFunc          func(string, string) error
+	UpdateIdpClientFunc           func(*IdpClient, string) error
+}
+
+// CreateIdpClient calls CreateIdpClientFunc.
+func (f *Fake) CreateIdpClient(idpClient *IdpClient) (response.Identifier, error) {
+	if f.CreateIdpClientFunc == nil {
+		var r0 response.Identifier
+		return r0, errors.New("auth: Fake.CreateIdpClient is not implemented")
+	}
+	return f.CreateIdpClientFunc(idpClient)
+}
+
+// DeleteIdpClient calls DeleteIdpClientFunc.
+func (f *Fake) DeleteIdpClient(idpID string) error {
+	if f.DeleteIdpClientFunc == nil {
+		return errors.New("auth: Fake.DeleteIdpClient is not implemented")
+	}
+	return f.DeleteIdpClientFunc(idpID)
+}
+
+// GetIdpClient calls GetIdpClientFunc.
+func (f *Fake) GetIdpClient(idpID string) (*IdpClient, error) {
+	if f.GetIdpClientFunc == nil {
+		var r0 *IdpClient
+		return r0, errors.New("auth: Fake.GetIdpClient is not implemented")
+	}
+	return f.GetIdpClientFunc(idpID)
+}
+
+// GetSourceSessions calls GetSourceSessionsFunc.
+func (f *Fake) GetSourceSessions(sourceID string, opts ...filters.Option) (*response.ResultSet[Session], error) {
+	if f.GetSourceSessionsFunc == nil {
+		var r0 *response.ResultSet[Session]
+		return r0, errors.New("auth: Fake.GetSourceSessions is not implemented")
+	}
+	return f.GetSourceSessionsFunc(sourceID, opts...)
+}
+
+// GetUserPairedDevices calls GetUserPairedDevicesFunc.
+func (f *Fake) GetUserPairedDevices(userID string) (*response.ResultSet[Device], error) {
+	if f.GetUserPairedDevicesFunc == nil {
+		var r0 *response.ResultSet[Device]
+		return r0, errors.New("auth: Fake.GetUserPairedDevices is not implemented")
+	}
+	return f.GetUserPairedDevicesFunc(userID)
+}
+
+// GetUserSessions calls GetUserSessionsFunc.
+func (f *Fake) GetUserSessions(userID string, opts ...filters.Option) (*response.ResultSet[Session], error) {
+	if f.GetUserSessionsFunc == nil {
+		var r0 *response.ResultSet[Session]
+		return r0, errors.New("auth: Fake.GetUserSessions is not implemented")
+	}
+	return f.GetUserSessionsFunc(userID, opts...)
+}
+
+// Logout calls LogoutFunc.
+func (f *Fake) Logout() error {
+	if f.LogoutFunc == nil {
+		return errors.New("auth: Fake.Logout is not implemented")
+	}
+	return f.LogoutFunc()
+}
+
+// RegenerateIdpClientConfig calls RegenerateIdpClientConfigFunc.
+func (f *Fake) RegenerateIdpClientConfig(idpID string) (*IdpClientConfig, error) {
+	if f.RegenerateIdpClientConfigFunc == nil {
+		var r0 *IdpClientConfig
+		return r0, errors.New("auth: Fake.RegenerateIdpClientConfig is not implemented")
+	}
+	return f.RegenerateIdpClientConfigFunc(idpID)
+}
+
+// SearchSessions calls SearchSessionsFunc.
+func (f *Fake) SearchSessions(search *SessionSearch, opts ...filters.Option) (*response.ResultSet[Session], error) {
+	if f.SearchSessionsFunc == nil {
+		var r0 *response.ResultSet[Session]
+		return r0, errors.New("auth: Fake.SearchSessions is not implemented")
+	}
+	return f.SearchSessionsFunc(search, opts...)
+}
+
+// Status calls StatusFunc.
+func (f *Fake) Status() (*response.ServiceStatus, error) {
+	if f.StatusFunc == nil {
+		var r0 *response.ServiceStatus
+		return r0, errors.New("auth: Fake.Status is not implemented")
+	}
+	return f.StatusFunc()
+}
+
+// TerminateSession calls TerminateSessionFunc.
+func (f *Fake) TerminateSession(sessionID string) error {
+	if f.TerminateSessionFunc == nil {
+		return errors.New("auth: Fake.TerminateSession is not implemented")
+	}
+	return f.TerminateSessionFunc(sessionID)
+}
+
+// TerminateUserSessions calls TerminateUserSessionsFunc.
+func (f *Fake) TerminateUserSessions(userID string) error {
+	if f.TerminateUserSessionsFunc == nil {
+		return errors.New("auth: Fake.TerminateUserSessions is not implemented")
+	}
+	return f.TerminateUserSessionsFunc(userID)
+}
+
+// UnpairUserDevice calls UnpairUserDeviceFunc.
+func (f *Fake) UnpairUserDevice(userID string, deviceID string) error {
+	if f.UnpairUserDeviceFunc == nil {
+		return errors.New("auth: Fake.UnpairUserDevice is not implemented")
+	}
+	return f.UnpairUserDeviceFunc(userID, deviceID)
+}
+
+// UpdateIdpClient calls UpdateIdpClientFunc.
+func (f *Fake) UpdateIdpClient(idpClient *IdpClient, idpID string) error {
+	if f.UpdateIdpClientFunc == nil {
+		return errors.New("auth: Fake.UpdateIdpClient is not implemented")
+	}
+	return f.UpdateIdpClientFunc(idpClient, idpID)
+}
@@ -6,6 +6,8 @@
 
 package authorizer
 
+//go:generate go run github.com/SSHcom/privx-sdk-go/v2/internal/mockgen
+
 import (
 	"net/url"
 
@@ -44,8 +46,8 @@ func (c *Authorizer) GetCACertificates(opts ...filters.Option) (*response.Result
 	cas := []CA{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -106,8 +108,8 @@ func (c *Authorizer) GetTargetHostCredentials(request *ApiIdentities, opts ...fi
 	principal := &ApiIdentitiesResponse{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -144,8 +146,8 @@ func (c *Authorizer) GetPrincipal(groupID string, opts ...filters.Option) (*Prin
 	principal := &Principal{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -160,8 +162,8 @@ func (c *Authorizer) GetPrincipal(groupID string, opts ...filters.Option) (*Prin
 func (c *Authorizer) DeletePrincipalKey(groupID string, opts ...filters.Option) error {
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return err
 	}
 
 	_, err := c.api.
@@ -199,8 +201,8 @@ func (c *Authorizer) SignPrincipalKey(groupID string, sign *PrincipalKeySign, op
 	signature := &Signature{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -219,8 +221,8 @@ func (c *Authorizer) GetExtenderCACertificates(opts ...filters.Option) (*respons
 	cs := []CA{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -335,8 +337,8 @@ func (c *Authorizer) GetWebProxyCACertificates(opts ...filters.Option) (*respons
 	cs := []CA{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -400,8 +402,8 @@ func (c *Authorizer) GetCertTemplates(opts ...filters.Option) (*response.ResultS
 	templates := &response.ResultSet[CertTemplate]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -441,8 +443,8 @@ func (c *Authorizer) GetAccessGroups(opts ...filters.Option) (*response.ResultSe
 	accessGroups := &response.ResultSet[AccessGroup]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -469,8 +471,8 @@ func (c *Authorizer) SearchAccessGroups(search *AccessGroupSearch, opts ...filte
 	accessGroups := &response.ResultSet[AccessGroup]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -536,8 +538,8 @@ func (c *Authorizer) SearchCerts(search *ApiCertificateSearch, opts ...filters.O
 	certs := &response.ResultSet[ApiCertificate]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -576,8 +578,8 @@ func (c *Authorizer) GetAccountSecrets(opts ...filters.Option) (*response.Result
 	secrets := &response.ResultSet[HostAccountSecret]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -593,8 +595,8 @@ func (c *Authorizer) SearchAccountSecrets(search *AccountSecretSearch, opts ...f
 	secrets := &response.ResultSet[HostAccountSecret]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -610,8 +612,8 @@ func (c *Authorizer) GetSecretCheckouts(opts ...filters.Option) (*response.Resul
 	checkouts := &response.ResultSet[Checkout]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
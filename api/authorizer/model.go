@@ -10,6 +10,7 @@ import (
 	"net"
 	"time"
 
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
 	"github.com/SSHcom/privx-sdk-go/v2/api/secretsmanager"
 )
 
@@ -30,40 +31,40 @@ type CertTemplateParams struct {
 
 // ApiCertificate api certificate definition.
 type ApiCertificate struct {
-	Type              string `json:"type,omitempty"`
-	ID                string `json:"id,omitempty"`
-	Serial            string `json:"serial"`
-	OwnerID           string `json:"owner_id,omitempty"`
-	Revoked           string `json:"revoked,omitempty"`
-	RevocationReason  string `json:"revocation_reason,omitempty"`
-	Cert              string `json:"cert,omitempty"`
-	Chain             string `json:"chain,omitempty"`
-	Issuer            string `json:"issuer,omitempty"`
-	Subject           string `json:"subject,omitempty"`
-	NotBefore         string `json:"not_before,omitempty"`
-	NotAfter          string `json:"not_after,omitempty"`
-	KeyUsage          string `json:"key_usage,omitempty"`
-	BasicConstraints  string `json:"basic_constraints,omitempty"`
-	Extensions        string `json:"extensions,omitempty"`
-	FingerPrintSHA1   string `json:"fingerprint_sha1,omitempty"`
-	FingerPrintSHA256 string `json:"fingerprint_sha256,omitempty"`
-	SubjectKeyID      string `json:"subject_key_id,omitempty"`
-	AuthorityKeyID    string `json:"authority_key_id,omitempty"`
-	Status            string `json:"status"`
+	Type              string             `json:"type,omitempty"`
+	ID                string             `json:"id,omitempty"`
+	Serial            string             `json:"serial"`
+	OwnerID           string             `json:"owner_id,omitempty"`
+	Revoked           string             `json:"revoked,omitempty"`
+	RevocationReason  string             `json:"revocation_reason,omitempty"`
+	Cert              string             `json:"cert,omitempty"`
+	Chain             string             `json:"chain,omitempty"`
+	Issuer            string             `json:"issuer,omitempty"`
+	Subject           string             `json:"subject,omitempty"`
+	NotBefore         response.Timestamp `json:"not_before,omitzero"`
+	NotAfter          response.Timestamp `json:"not_after,omitzero"`
+	KeyUsage          string             `json:"key_usage,omitempty"`
+	BasicConstraints  string             `json:"basic_constraints,omitempty"`
+	Extensions        string             `json:"extensions,omitempty"`
+	FingerPrintSHA1   string             `json:"fingerprint_sha1,omitempty"`
+	FingerPrintSHA256 string             `json:"fingerprint_sha256,omitempty"`
+	SubjectKeyID      string             `json:"subject_key_id,omitempty"`
+	AuthorityKeyID    string             `json:"authority_key_id,omitempty"`
+	Status            string             `json:"status"`
 }
 
 // ApiCertificateSearch api certificate search definition.
 type ApiCertificateSearch struct {
-	Type           string `json:"type"`
-	ID             string `json:"id,omitempty"`
-	KeyID          string `json:"key_id,omitempty"`
-	OwnerID        string `json:"owner_id,omitempty"`
-	Subject        string `json:"subject,omitempty"`
-	Issuer         string `json:"issuer,omitempty"`
-	NotBefore      string `json:"not_before,omitempty"`
-	NotAfter       string `json:"not_after,omitempty"`
-	IncludeRevoked bool   `json:"include_revoked,omitempty"`
-	IncludeExpired bool   `json:"include_expired,omitempty"`
+	Type           string             `json:"type"`
+	ID             string             `json:"id,omitempty"`
+	KeyID          string             `json:"key_id,omitempty"`
+	OwnerID        string             `json:"owner_id,omitempty"`
+	Subject        string             `json:"subject,omitempty"`
+	Issuer         string             `json:"issuer,omitempty"`
+	NotBefore      response.Timestamp `json:"not_before,omitzero"`
+	NotAfter       response.Timestamp `json:"not_after,omitzero"`
+	IncludeRevoked bool               `json:"include_revoked,omitempty"`
+	IncludeExpired bool               `json:"include_expired,omitempty"`
 }
 
 // TrustAnchor trust anchor definition
@@ -160,22 +161,22 @@ type ApiSshKey struct {
 
 // CA root certificate definition.
 type CA struct {
-	ID                string `json:"id"`
-	GroupID           string `json:"group_id"`
-	AccessGroupID     string `json:"access_group_id,omitempty"`
-	Type              string `json:"type"`
-	Size              int    `json:"size"`
-	PublicKey         string `json:"public_key"`
-	Comment           string `json:"comment,omitempty"`
-	PublicKeyString   string `json:"public_key_string"`
-	X509Certificate   string `json:"x509_certificate,omitempty"`
-	Subject           string `json:"subject,omitempty"`
-	Issuer            string `json:"issuer,omitempty"`
-	SerialNumber      string `json:"serial,omitempty"`
-	NotBefore         string `json:"not_before,omitempty"`
-	NotAfter          string `json:"not_after,omitempty"`
-	FingerPrintSHA1   string `json:"fingerprint_sha1,omitempty"`
-	FingerPrintSHA256 string `json:"fingerprint_sha256,omitempty"`
+	ID                string             `json:"id"`
+	GroupID           string             `json:"group_id"`
+	AccessGroupID     string             `json:"access_group_id,omitempty"`
+	Type              string             `json:"type"`
+	Size              int                `json:"size"`
+	PublicKey         string             `json:"public_key"`
+	Comment           string             `json:"comment,omitempty"`
+	PublicKeyString   string             `json:"public_key_string"`
+	X509Certificate   string             `json:"x509_certificate,omitempty"`
+	Subject           string             `json:"subject,omitempty"`
+	Issuer            string             `json:"issuer,omitempty"`
+	SerialNumber      string             `json:"serial,omitempty"`
+	NotBefore         response.Timestamp `json:"not_before,omitzero"`
+	NotAfter          response.Timestamp `json:"not_after,omitzero"`
+	FingerPrintSHA1   string             `json:"fingerprint_sha1,omitempty"`
+	FingerPrintSHA256 string             `json:"fingerprint_sha256,omitempty"`
 }
 
 // Principal principal definition.
@@ -220,20 +221,20 @@ type CertificateRevocationResponse struct {
 
 // AccessGroup access group definition.
 type AccessGroup struct {
-	ID                               string `json:"id,omitempty"`
-	Name                             string `json:"name,omitempty"`
-	Comment                          string `json:"comment,omitempty"`
-	HostCertificateTrustAnchors      string `json:"host_certificate_trust_anchors"`
-	WinRMHostCertificateTrustAnchors string `json:"winrm_host_certificate_trust_anchors"`
-	DBHostCertificateTrustAnchors    string `json:"db_host_certificate_trust_anchors"`
-	CAID                             string `json:"ca_id,omitempty"`
-	PrimaryCAID                      string `json:"primary_ca_id"`
-	Author                           string `json:"author,omitempty"`
-	Created                          string `json:"created,omitempty"`
-	Updated                          string `json:"updated,omitempty"`
-	UpdatedBy                        string `json:"updated_by,omitempty"`
-	Default                          bool   `json:"default,omitempty"`
-	CAKeyType                        string `json:"key_type"`
+	ID                               string             `json:"id,omitempty"`
+	Name                             string             `json:"name,omitempty"`
+	Comment                          string             `json:"comment,omitempty"`
+	HostCertificateTrustAnchors      string             `json:"host_certificate_trust_anchors"`
+	WinRMHostCertificateTrustAnchors string             `json:"winrm_host_certificate_trust_anchors"`
+	DBHostCertificateTrustAnchors    string             `json:"db_host_certificate_trust_anchors"`
+	CAID                             string             `json:"ca_id,omitempty"`
+	PrimaryCAID                      string             `json:"primary_ca_id"`
+	Author                           string             `json:"author,omitempty"`
+	Created                          response.Timestamp `json:"created,omitzero"`
+	Updated                          response.Timestamp `json:"updated,omitzero"`
+	UpdatedBy                        string             `json:"updated_by,omitempty"`
+	Default                          bool               `json:"default,omitempty"`
+	CAKeyType                        string             `json:"key_type"`
 }
 
 // AccessGroupCARenewal access group ca renewal request body definition.
@@ -256,8 +257,8 @@ type HostAccountSecret struct {
 	FullName     string               `json:"full_name,omitempty"`
 	TargetDomain TargetDomainHandle   `json:"target_domain,omitempty"`
 	Host         HostPrincipalsHandle `json:"host,omitempty"`
-	Created      string               `json:"created,omitempty"`
-	Updated      string               `json:"updated,omitempty"`
+	Created      response.Timestamp   `json:"created,omitzero"`
+	Updated      response.Timestamp   `json:"updated,omitzero"`
 }
 
 // TargetDomainHandle target domain handle definition.
@@ -288,8 +289,8 @@ type Checkout struct {
 	ID               string                            `json:"id"`
 	Path             string                            `json:"path"`
 	Type             string                            `json:"type"`
-	Expires          string                            `json:"expires"`
-	Created          string                            `json:"created"`
+	Expires          response.Timestamp                `json:"expires"`
+	Created          response.Timestamp                `json:"created"`
 	ExplicitCheckout bool                              `json:"explicit_checkout"`
 	Secrets          []Secrets                         `json:"secrets"`
 	Username         string                            `json:"username"`
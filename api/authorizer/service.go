@@ -0,0 +1,593 @@
+// Code generated by mockgen from Authorizer method set; DO NOT EDIT.
+
+package authorizer
+
+import (
+	"errors"
+
+	"github.com/SSHcom/privx-sdk-go/v2/api/filters"
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
+)
+
+// Service is the interface of Authorizer client, it allows substitution of
+// the client in tests, see Fake.
+type Service interface {
+	// CAConfig get authorizers root certificate config by ca type.
+	CAConfig(caType string) (ComponentCaConfig, error)
+	// CheckoutAccountSecret checkout account secret.
+	CheckoutAccountSecret(checkout CheckoutRequest) (*response.ResultSet[Checkout], error)
+	// CreateAccessGroup create access group.
+	CreateAccessGroup(accessGroup *AccessGroup) (response.Identifier, error)
+	// CreatePrincipalKey create a principal key pair.
+	CreatePrincipalKey(groupID string) (*Principal, error)
+	// DeleteAccessGroup delete access group by id.
+	DeleteAccessGroup(accessGroupID string) error
+	// DeletePrincipalKey delete the principal key by its group id.
+	DeletePrincipalKey(groupID string, opts ...filters.Option) error
+	// DownloadCACertificate fetch authorizers root certificate as a download object.
+	DownloadCACertificate(caID string, filename string) error
+	// DownloadCarrierConfig fetch a pre-configured carrier config.
+	DownloadCarrierConfig(trustedClientID string, sessionID string, filename string) error
+	// DownloadCertificateRevocationList fetch authorizer CA certificate revocation list as a download object.
+	DownloadCertificateRevocationList(caID string, filename string) error
+	// DownloadDeployScript fetch a pre-configured deployment script.
+	DownloadDeployScript(trustedClientID string, sessionID string, filename string) error
+	// DownloadExtenderCACertificate fetch authorizers extender CA certificate by id as a download object.
+	DownloadExtenderCACertificate(filename string, id string) error
+	// DownloadExtenderCertificateCRL fetch authorizer CA certificate revocation list as a download object.
+	DownloadExtenderCertificateCRL(filename string, id string) error
+	// DownloadExtenderConfig fetch a pre-configured extender config as a download object.
+	DownloadExtenderConfig(trustedClientID string, sessionID string, filename string) error
+	// DownloadPrincipalCommandScript fetch the principals_command.sh script.
+	DownloadPrincipalCommandScript(filename string) error
+	// DownloadWebProxyCertificateCRL fetch authorizer CA certificate revocation list as a download object.
+	DownloadWebProxyCertificateCRL(filename string, id string) error
+	// DownloadWebProxyConfig fetch a pre-configured web proxy config as a download object.
+	DownloadWebProxyConfig(trustedClientID string, sessionID string, filename string) error
+	// GetAccessGroup get access group by id.
+	GetAccessGroup(accessGroupID string) (*AccessGroup, error)
+	// GetAccessGroups get all access group.
+	GetAccessGroups(opts ...filters.Option) (*response.ResultSet[AccessGroup], error)
+	// GetAccountSecrets get all account secrets.
+	GetAccountSecrets(opts ...filters.Option) (*response.ResultSet[HostAccountSecret], error)
+	// GetAllCertificates get all certificates.
+	GetAllCertificates() (*response.ResultSet[ApiCertificate], error)
+	// GetCACertificates get authorizers root certificates.
+	// Note, the v1 endpoint doesn't return the count as part of the response body,
+	// this will change with v2. Until then, we will handle it internally within the SDK.
+	GetCACertificates(opts ...filters.Option) (*response.ResultSet[CA], error)
+	// // GetCarrierConfigSessions get carrier config session ids.
+	GetCarrierConfigSessions(trustedClientID string) (*SessionIDResponse, error)
+	// GetCert get certificate by id.
+	GetCert(certID string) (*ApiCertificate, error)
+	// GetCertTemplates returns the certificate authentication templates.
+	GetCertTemplates(opts ...filters.Option) (*response.ResultSet[CertTemplate], error)
+	// GetDeployScriptSessions get deploy script session ids.
+	GetDeployScriptSessions(trustedClientID string) (*SessionIDResponse, error)
+	// GetExtenderCACertificates gets authorizers extender CA certificates.
+	// Note, the v1 endpoint doesn't return the count as part of the response body,
+	// this will change with v2. Until then, we will handle it internally within the SDK.
+	GetExtenderCACertificates(opts ...filters.Option) (*response.ResultSet[CA], error)
+	// GetExtenderConfigSessions get extenders config session ids.
+	GetExtenderConfigSessions(trustedClientID string) (*SessionIDResponse, error)
+	// GetExtenderTrustAnchor returns the extender trust anchor.
+	GetExtenderTrustAnchor() (*TrustAnchor, error)
+	// GetPrincipal get principal by its group id.
+	GetPrincipal(groupID string, opts ...filters.Option) (*Principal, error)
+	// GetPrincipals get defined principals.
+	// Note, the v1 endpoint doesn't return the count as part of the response body,
+	// this will change with v2. Until then, we will handle it internally within the SDK.
+	GetPrincipals() (*response.ResultSet[Principal], error)
+	// GetSSLTrustAnchor returns the SSL trust anchor.
+	GetSSLTrustAnchor() (*TrustAnchor, error)
+	// GetSecretCheckout get secret checkout by id.
+	GetSecretCheckout(checkoutID string) (*Checkout, error)
+	// GetSecretCheckouts get secret checkouts.
+	GetSecretCheckouts(opts ...filters.Option) (*response.ResultSet[Checkout], error)
+	// GetTargetHostCredentials get target host credentials for the user.
+	GetTargetHostCredentials(request *ApiIdentities, opts ...filters.Option) (*ApiIdentitiesResponse, error)
+	// GetWebProxyCACertificate gets authorizer's web proxy CA certificate by id.
+	GetWebProxyCACertificate(id string) (*CA, error)
+	// GetWebProxyCACertificates gets authorizer's web proxy CA certificates.
+	// Note, the v1 endpoint doesn't return the count as part of the response body,
+	// this will change with v2. Until then, we will handle it internally within the SDK.
+	GetWebProxyCACertificates(opts ...filters.Option) (*response.ResultSet[CA], error)
+	// GetWebProxyConfigSessions get web proxy config session ids.
+	GetWebProxyConfigSessions(trustedClientID string) (*SessionIDResponse, error)
+	// ImportPrincipalKey import a principal key pair.
+	ImportPrincipalKey(groupID string, key *PrincipalKeyImport) (*Principal, error)
+	// ReleaseSecretCheckout release secret checkout.
+	ReleaseSecretCheckout(checkoutID string) error
+	// RenewAccessGroupCAKey renew access group CA key.
+	RenewAccessGroupCAKey(accessGroupID string, renewal *AccessGroupCARenewal) (string, error)
+	// RevokeAccessGroupCAKey revoke access group CA key.
+	RevokeAccessGroupCAKey(accessGroupID string, caID string) error
+	// SearchAccessGroups search for access groups.
+	SearchAccessGroups(search *AccessGroupSearch, opts ...filters.Option) (*response.ResultSet[AccessGroup], error)
+	// SearchAccountSecrets search for account secrets.
+	SearchAccountSecrets(search *AccountSecretSearch, opts ...filters.Option) (*response.ResultSet[HostAccountSecret], error)
+	// SearchCerts search certificates.
+	SearchCerts(search *ApiCertificateSearch, opts ...filters.Option) (*response.ResultSet[ApiCertificate], error)
+	// SignPrincipalKey get a principal key signature.
+	SignPrincipalKey(groupID string, sign *PrincipalKeySign, opts ...filters.Option) (*Signature, error)
+	// Status get authorizer microservice status.
+	Status() (*response.ServiceStatus, error)
+	// UpdateAccessGroup update access group by id.
+	UpdateAccessGroup(accessGroupID string, update *AccessGroup) error
+	// UpdateCAConfig update authorizers root certificate config by ca type.
+	UpdateCAConfig(caType string, caConf ComponentCaConfig) error
+}
+
+var (
+	_ Service = (*Authorizer)(nil)
+	_ Service = (*Fake)(nil)
+)
+
+// Fake is a fake of Authorizer client. Methods delegate to the corresponding
+// function fields, undefined functions return an error.
+type Fake struct {
+	CAConfigFunc                          func(string) (ComponentCaConfig, error)
+	CheckoutAccountSecretFunc             func(CheckoutRequest) (*response.ResultSet[Checkout], error)
+	CreateAccessGroupFunc                 func(*AccessGroup) (response.Identifier, error)
+	CreatePrincipalKeyFunc                func(string) (*Principal, error)
+	DeleteAccessGroupFunc                 func(string) error
+	DeletePrincipalKeyFunc                func(string, ...filters.Option) error
+	DownloadCACertificateFunc             func(string, string) error
+	DownloadCarrierConfigFunc             func(string, string, string) error
+	DownloadCertificateRevocationListFunc func(string, string) error
+	DownloadDeployScriptFunc              func(string, string, string) error
+	DownloadExtenderCACertificateFunc     func(string, string) error
+	DownloadExtenderCertificateCRLFunc    func(string, string) error
+	DownloadExtenderConfigFunc            func(string, string, string) error
+	DownloadPrincipalCommandScriptFunc    func(string) error
+	DownloadWebProxyCertificateCRLFunc    func(string, string) error
+	DownloadWebProxyConfigFunc            func(string, string, string) error
+	GetAccessGroupFunc                    func(string) (*AccessGroup, error)
+	GetAccessGroupsFunc                   func(...filters.Option) (*response.ResultSet[AccessGroup], error)
+	GetAccountSecretsFunc                 func(...filters.Option) (*response.ResultSet[HostAccountSecret], error)
+	GetAllCertificatesFunc                func() (*response.ResultSet[ApiCertificate], error)
+	GetCACertificatesFunc                 func(...filters.Option) (*response.ResultSet[CA], error)
+	GetCarrierConfigSessionsFunc          func(string) (*SessionIDResponse, error)
+	GetCertFunc                           func(string) (*ApiCertificate, error)
+	GetCertTemplatesFunc                  func(...filters.Option) (*response.ResultSet[CertTemplate], error)
+	GetDeployScriptSessionsFunc           func(string) (*SessionIDResponse, error)
+	GetExtenderCACertificatesFunc         func(...filters.Option) (*response.ResultSet[CA], error)
+	GetExtenderConfigSessionsFunc         func(string) (*SessionIDResponse, error)
+	GetExtenderTrustAnchorFunc            func() (*TrustAnchor, error)
+	GetPrincipalFunc                      func(string, ...filters.Option) (*Principal, error)
+	GetPrincipalsFunc                     func() (*response.ResultSet[Principal], error)
+	GetSSLTrustAnchorFunc                 func() (*TrustAnchor, error)
+	GetSecretCheckoutFunc                 func(string) (*Checkout, error)
+	GetSecretCheckoutsFunc                func(...filters.Option) (*response.ResultSet[Checkout], error)
+	GetTargetHostCredentialsFunc          func(*ApiIdentities, ...filters.Option) (*ApiIdentitiesResponse, error)
+	GetWebProxyCACertificateFunc          func(string) (*CA, error)
+	GetWebProxyCACertificatesFunc         func(...filters.Option) (*response.ResultSet[CA], error)
+	GetWebProxyConfigSessionsFunc         func(string) (*SessionIDResponse, error)
+	ImportPrincipalKeyFunc                func(string, *PrincipalKeyImport) (*Principal, error)
+	ReleaseSecretCheckoutFunc             func(string) error
+	RenewAccessGroupCAKeyFunc             func(string, *AccessGroupCARenewal) (string, error)
+	RevokeAccessGroupCAKeyFunc            func(string, string) error
+	SearchAccessGroupsFunc                func(*AccessGroupSearch, ...filters.Option) (*response.ResultSet[AccessGroup], error)
+	SearchAccountSecretsFunc              func(*AccountSecretSearch, ...filters.Option) (*response.ResultSet[HostAccountSecret], error)
+	SearchCertsFunc                       func(*ApiCertificateSearch, ...filters.Option) (*response.ResultSet[ApiCertificate], error)
+	SignPrincipalKeyFunc                  func(string, *PrincipalKeySign, ...filters.Option) (*Signature, error)
+	StatusFunc                            func() (*response.ServiceStatus, error)
+	UpdateAccessGroupFunc                 func(string, *AccessGroup) error
+	UpdateCAConfigFunc                    func(string, ComponentCaConfig) error
+}
+
+// CAConfig calls CAConfigFunc.
+func (f *Fake) CAConfig(caType string) (ComponentCaConfig, error) {
+	if f.CAConfigFunc == nil {
+		var r0 ComponentCaConfig
+		return r0, errors.New("authorizer: Fake.CAConfig is not implemented")
+	}
+	return f.CAConfigFunc(caType)
+}
+
+// CheckoutAccountSecret calls CheckoutAccountSecretFunc.
+func (f *Fake) CheckoutAccountSecret(checkout CheckoutRequest) (*response.ResultSet[Checkout], error) {
+	if f.CheckoutAccountSecretFunc == nil {
+		var r0 *response.ResultSet[Checkout]
+		return r0, errors.New("authorizer: Fake.CheckoutAccountSecret is not implemented")
+	}
+	return f.CheckoutAccountSecretFunc(checkout)
+}
+
+// CreateAccessGroup calls CreateAccessGroupFunc.
+func (f *Fake) CreateAccessGroup(accessGroup *AccessGroup) (response.Identifier, error) {
+	if f.CreateAccessGroupFunc == nil {
+		var r0 response.Identifier
+		return r0, errors.New("authorizer: Fake.CreateAccessGroup is not implemented")
+	}
+	return f.CreateAccessGroupFunc(accessGroup)
+}
+
+// CreatePrincipalKey calls CreatePrincipalKeyFunc.
+func (f *Fake) CreatePrincipalKey(groupID string) (*Principal, error) {
+	if f.CreatePrincipalKeyFunc == nil {
+		var r0 *Principal
+		return r0, errors.New("authorizer: Fake.CreatePrincipalKey is not implemented")
+	}
+	return f.CreatePrincipalKeyFunc(groupID)
+}
+
+// DeleteAccessGroup calls DeleteAccessGroupFunc.
+func (f *Fake) DeleteAccessGroup(accessGroupID string) error {
+	if f.DeleteAccessGroupFunc == nil {
+		return errors.New("authorizer: Fake.DeleteAccessGroup is not implemented")
+	}
+	return f.DeleteAccessGroupFunc(accessGroupID)
+}
+
+// DeletePrincipalKey calls DeletePrincipalKeyFunc.
+func (f *Fake) DeletePrincipalKey(groupID string, opts ...filters.Option) error {
+	if f.DeletePrincipalKeyFunc == nil {
+		return errors.New("authorizer: Fake.DeletePrincipalKey is not implemented")
+	}
+	return f.DeletePrincipalKeyFunc(groupID, opts...)
+}
+
+// DownloadCACertificate calls DownloadCACertificateFunc.
+func (f *Fake) DownloadCACertificate(caID string, filename string) error {
+	if f.DownloadCACertificateFunc == nil {
+		return errors.New("authorizer: Fake.DownloadCACertificate is not implemented")
+	}
+	return f.DownloadCACertificateFunc(caID, filename)
+}
+
+// DownloadCarrierConfig calls DownloadCarrierConfigFunc.
+func (f *Fake) DownloadCarrierConfig(trustedClientID string, sessionID string, filename string) error {
+	if f.DownloadCarrierConfigFunc == nil {
+		return errors.New("authorizer: Fake.DownloadCarrierConfig is not implemented")
+	}
+	return f.DownloadCarrierConfigFunc(trustedClientID, sessionID, filename)
+}
+
+// DownloadCertificateRevocationList calls DownloadCertificateRevocationListFunc.
+func (f *Fake) DownloadCertificateRevocationList(caID string, filename string) error {
+	if f.DownloadCertificateRevocationListFunc == nil {
+		return errors.New("authorizer: Fake.DownloadCertificateRevocationList is not implemented")
+	}
+	return f.DownloadCertificateRevocationListFunc(caID, filename)
+}
+
+// DownloadDeployScript calls DownloadDeployScriptFunc.
+func (f *Fake) DownloadDeployScript(trustedClientID string, sessionID string, filename string) error {
+	if f.DownloadDeployScriptFunc == nil {
+		return errors.New("authorizer: Fake.DownloadDeployScript is not implemented")
+	}
+	return f.DownloadDeployScriptFunc(trustedClientID, sessionID, filename)
+}
+
+// DownloadExtenderCACertificate calls DownloadExtenderCACertificateFunc.
+func (f *Fake) DownloadExtenderCACertificate(filename string, id string) error {
+	if f.DownloadExtenderCACertificateFunc == nil {
+		return errors.New("authorizer: Fake.DownloadExtenderCACertificate is not implemented")
+	}
+	return f.DownloadExtenderCACertificateFunc(filename, id)
+}
+
+// DownloadExtenderCertificateCRL calls DownloadExtenderCertificateCRLFunc.
+func (f *Fake) DownloadExtenderCertificateCRL(filename string, id string) error {
+	if f.DownloadExtenderCertificateCRLFunc == nil {
+		return errors.New("authorizer: Fake.DownloadExtenderCertificateCRL is not implemented")
+	}
+	return f.DownloadExtenderCertificateCRLFunc(filename, id)
+}
+
+// DownloadExtenderConfig calls DownloadExtenderConfigFunc.
+func (f *Fake) DownloadExtenderConfig(trustedClientID string, sessionID string, filename string) error {
+	if f.DownloadExtenderConfigFunc == nil {
+		return errors.New("authorizer: Fake.DownloadExtenderConfig is not implemented")
+	}
+	return f.DownloadExtenderConfigFunc(trustedClientID, sessionID, filename)
+}
+
+// DownloadPrincipalCommandScript calls DownloadPrincipalCommandScriptFunc.
+func (f *Fake) DownloadPrincipalCommandScript(filename string) error {
+	if f.DownloadPrincipalCommandScriptFunc == nil {
+		return errors.New("authorizer: Fake.DownloadPrincipalCommandScript is not implemented")
+	}
+	return f.DownloadPrincipalCommandScriptFunc(filename)
+}
+
+// DownloadWebProxyCertificateCRL calls DownloadWebProxyCertificateCRLFunc.
+func (f *Fake) DownloadWebProxyCertificateCRL(filename string, id string) error {
+	if f.DownloadWebProxyCertificateCRLFunc == nil {
+		return errors.New("authorizer: Fake.DownloadWebProxyCertificateCRL is not implemented")
+	}
+	return f.DownloadWebProxyCertificateCRLFunc(filename, id)
+}
+
+// DownloadWebProxyConfig calls DownloadWebProxyConfigFunc.
+func (f *Fake) DownloadWebProxyConfig(trustedClientID string, sessionID string, filename string) error {
+	if f.DownloadWebProxyConfigFunc == nil {
+		return errors.New("authorizer: Fake.DownloadWebProxyConfig is not implemented")
+	}
+	return f.DownloadWebProxyConfigFunc(trustedClientID, sessionID, filename)
+}
+
+// GetAccessGroup calls GetAccessGroupFunc.
+func (f *Fake) GetAccessGroup(accessGroupID string) (*AccessGroup, error) {
+	if f.GetAccessGroupFunc == nil {
+		var r0 *AccessGroup
+		return r0, errors.New("authorizer: Fake.GetAccessGroup is not implemented")
+	}
+	return f.GetAccessGroupFunc(accessGroupID)
+}
+
+// GetAccessGroups calls GetAccessGroupsFunc.
+func (f *Fake) GetAccessGroups(opts ...filters.Option) (*response.ResultSet[AccessGroup], error) {
+	if f.GetAccessGroupsFunc == nil {
+		var r0 *response.ResultSet[AccessGroup]
+		return r0, errors.New("authorizer: Fake.GetAccessGroups is not implemented")
+	}
+	return f.GetAccessGroupsFunc(opts...)
+}
+
+// GetAccountSecrets calls GetAccountSecretsFunc.
+func (f *Fake) GetAccountSecrets(opts ...filters.Option) (*response.ResultSet[HostAccountSecret], error) {
+	if f.GetAccountSecretsFunc == nil {
+		var r0 *response.ResultSet[HostAccountSecret]
+		return r0, errors.New("authorizer: Fake.GetAccountSecrets is not implemented")
+	}
+	return f.GetAccountSecretsFunc(opts...)
+}
+
+// GetAllCertificates calls GetAllCertificatesFunc.
+func (f *Fake) GetAllCertificates() (*response.ResultSet[ApiCertificate], error) {
+	if f.GetAllCertificatesFunc == nil {
+		var r0 *response.ResultSet[ApiCertificate]
+		return r0, errors.New("authorizer: Fake.GetAllCertificates is not implemented")
+	}
+	return f.GetAllCertificatesFunc()
+}
+
+// GetCACertificates calls GetCACertificatesFunc.
+func (f *Fake) GetCACertificates(opts ...filters.Option) (*response.ResultSet[CA], error) {
+	if f.GetCACertificatesFunc == nil {
+		var r0 *response.ResultSet[CA]
+		return r0, errors.New("authorizer: Fake.GetCACertificates is not implemented")
+	}
+	return f.GetCACertificatesFunc(opts...)
+}
+
+// GetCarrierConfigSessions calls GetCarrierConfigSessionsFunc.
+func (f *Fake) GetCarrierConfigSessions(trustedClientID string) (*SessionIDResponse, error) {
+	if f.GetCarrierConfigSessionsFunc == nil {
+		var r0 *SessionIDResponse
+		return r0, errors.New("authorizer: Fake.GetCarrierConfigSessions is not implemented")
+	}
+	return f.GetCarrierConfigSessionsFunc(trustedClientID)
+}
+
+// GetCert calls GetCertFunc.
+func (f *Fake) GetCert(certID string) (*ApiCertificate, error) {
+	if f.GetCertFunc == nil {
+		var r0 *ApiCertificate
+		return r0, errors.New("authorizer: Fake.GetCert is not implemented")
+	}
+	return f.GetCertFunc(certID)
+}
+
+// GetCertTemplates calls GetCertTemplatesFunc.
+func (f *Fake) GetCertTemplates(opts ...filters.Option) (*response.ResultSet[CertTemplate], error) {
+	if f.GetCertTemplatesFunc == nil {
+		var r0 *response.ResultSet[CertTemplate]
+		return r0, errors.New("authorizer: Fake.GetCertTemplates is not implemented")
+	}
+	return f.GetCertTemplatesFunc(opts...)
+}
+
+// GetDeployScriptSessions calls GetDeployScriptSessionsFunc.
+func (f *Fake) GetDeployScriptSessions(trustedClientID string) (*SessionIDResponse, error) {
+	if f.GetDeployScriptSessionsFunc == nil {
+		var r0 *SessionIDResponse
+		return r0, errors.New("authorizer: Fake.GetDeployScriptSessions is not implemented")
+	}
+	return f.GetDeployScriptSessionsFunc(trustedClientID)
+}
+
+// GetExtenderCACertificates calls GetExtenderCACertificatesFunc.
+func (f *Fake) GetExtenderCACertificates(opts ...filters.Option) (*response.ResultSet[CA], error) {
+	if f.GetExtenderCACertificatesFunc == nil {
+		var r0 *response.ResultSet[CA]
+		return r0, errors.New("authorizer: Fake.GetExtenderCACertificates is not implemented")
+	}
+	return f.GetExtenderCACertificatesFunc(opts...)
+}
+
+// GetExtenderConfigSessions calls GetExtenderConfigSessionsFunc.
+func (f *Fake) GetExtenderConfigSessions(trustedClientID string) (*SessionIDResponse, error) {
+	if f.GetExtenderConfigSessionsFunc == nil {
+		var r0 *SessionIDResponse
+		return r0, errors.New("authorizer: Fake.GetExtenderConfigSessions is not implemented")
+	}
+	return f.GetExtenderConfigSessionsFunc(trustedClientID)
+}
+
+// GetExtenderTrustAnchor calls GetExtenderTrustAnchorFunc.
+func (f *Fake) GetExtenderTrustAnchor() (*TrustAnchor, error) {
+	if f.GetExtenderTrustAnchorFunc == nil {
+		var r0 *TrustAnchor
+		return r0, errors.New("authorizer: Fake.GetExtenderTrustAnchor is not implemented")
+	}
+	return f.GetExtenderTrustAnchorFunc()
+}
+
+// GetPrincipal calls GetPrincipalFunc.
+func (f *Fake) GetPrincipal(groupID string, opts ...filters.Option) (*Principal, error) {
+	if f.GetPrincipalFunc == nil {
+		var r0 *Principal
+		return r0, errors.New("authorizer: Fake.GetPrincipal is not implemented")
+	}
+	return f.GetPrincipalFunc(groupID, opts...)
+}
+
+// GetPrincipals calls GetPrincipalsFunc.
+func (f *Fake) GetPrincipals() (*response.ResultSet[Principal], error) {
+	if f.GetPrincipalsFunc == nil {
+		var r0 *response.ResultSet[Principal]
+		return r0, errors.New("authorizer: Fake.GetPrincipals is not implemented")
+	}
+	return f.GetPrincipalsFunc()
+}
+
+// GetSSLTrustAnchor calls GetSSLTrustAnchorFunc.
+func (f *Fake) GetSSLTrustAnchor() (*TrustAnchor, error) {
+	if f.GetSSLTrustAnchorFunc == nil {
+		var r0 *TrustAnchor
+		return r0, errors.New("authorizer: Fake.GetSSLTrustAnchor is not implemented")
+	}
+	return f.GetSSLTrustAnchorFunc()
+}
+
+// GetSecretCheckout calls GetSecretCheckoutFunc.
+func (f *Fake) GetSecretCheckout(checkoutID string) (*Checkout, error) {
+	if f.GetSecretCheckoutFunc == nil {
+		var r0 *Checkout
+		return r0, errors.New("authorizer: Fake.GetSecretCheckout is not implemented")
+	}
+	return f.GetSecretCheckoutFunc(checkoutID)
+}
+
+// GetSecretCheckouts calls GetSecretCheckoutsFunc.
+func (f *Fake) GetSecretCheckouts(opts ...filters.Option) (*response.ResultSet[Checkout], error) {
+	if f.GetSecretCheckoutsFunc == nil {
+		var r0 *response.ResultSet[Checkout]
+		return r0, errors.New("authorizer: Fake.GetSecretCheckouts is not implemented")
+	}
+	return f.GetSecretCheckoutsFunc(opts...)
+}
+
+// GetTargetHostCredentials calls GetTargetHostCredentialsFunc.
+func (f *Fake) GetTargetHostCredentials(request *ApiIdentities, opts ...filters.Option) (*ApiIdentitiesResponse, error) {
+	if f.GetTargetHostCredentialsFunc == nil {
+		var r0 *ApiIdentitiesResponse
+		return r0, errors.New("authorizer: Fake.GetTargetHostCredentials is not implemented")
+	}
+	return f.GetTargetHostCredentialsFunc(request, opts...)
+}
+
+// GetWebProxyCACertificate calls GetWebProxyCACertificateFunc.
+func (f *Fake) GetWebProxyCACertificate(id string) (*CA, error) {
+	if f.GetWebProxyCACertificateFunc == nil {
+		var r0 *CA
+		return r0, errors.New("authorizer: Fake.GetWebProxyCACertificate is not implemented")
+	}
+	return f.GetWebProxyCACertificateFunc(id)
+}
+
+// GetWebProxyCACertificates calls GetWebProxyCACertificatesFunc.
+func (f *Fake) GetWebProxyCACertificates(opts ...filters.Option) (*response.ResultSet[CA], error) {
+	if f.GetWebProxyCACertificatesFunc == nil {
+		var r0 *response.ResultSet[CA]
+		return r0, errors.New("authorizer: Fake.GetWebProxyCACertificates is not implemented")
+	}
+	return f.GetWebProxyCACertificatesFunc(opts...)
+}
+
+// GetWebProxyConfigSessions calls GetWebProxyConfigSessionsFunc.
+func (f *Fake) GetWebProxyConfigSessions(trustedClientID string) (*SessionIDResponse, error) {
+	if f.GetWebProxyConfigSessionsFunc == nil {
+		var r0 *SessionIDResponse
+		return r0, errors.New("authorizer: Fake.GetWebProxyConfigSessions is not implemented")
+	}
+	return f.GetWebProxyConfigSessionsFunc(trustedClientID)
+}
+
+// ImportPrincipalKey calls ImportPrincipalKeyFunc.
+func (f *Fake) ImportPrincipalKey(groupID string, key *PrincipalKeyImport) (*Principal, error) {
+	if f.ImportPrincipalKeyFunc == nil {
+		var r0 *Principal
+		return r0, errors.New("authorizer: Fake.ImportPrincipalKey is not implemented")
+	}
+	return f.ImportPrincipalKeyFunc(groupID, key)
+}
+
+// ReleaseSecretCheckout calls ReleaseSecretCheckoutFunc.
+func (f *Fake) ReleaseSecretCheckout(checkoutID string) error {
+	if f.ReleaseSecretCheckoutFunc == nil {
+		return errors.New("authorizer: Fake.ReleaseSecretCheckout is not implemented")
+	}
+	return f.ReleaseSecretCheckoutFunc(checkoutID)
+}
+
+// RenewAccessGroupCAKey calls RenewAccessGroupCAKeyFunc.
+func (f *Fake) RenewAccessGroupCAKey(accessGroupID string, renewal *AccessGroupCARenewal) (string, error) {
+	if f.RenewAccessGroupCAKeyFunc == nil {
+		var r0 string
+		return r0, errors.New("authorizer: Fake.RenewAccessGroupCAKey is not implemented")
+	}
+	return f.RenewAccessGroupCAKeyFunc(accessGroupID, renewal)
+}
+
+// RevokeAccessGroupCAKey calls RevokeAccessGroupCAKeyFunc.
+func (f *Fake) RevokeAccessGroupCAKey(accessGroupID string, caID string) error {
+	if f.RevokeAccessGroupCAKeyFunc == nil {
+		return errors.New("authorizer: Fake.RevokeAccessGroupCAKey is not implemented")
+	}
+	return f.RevokeAccessGroupCAKeyFunc(accessGroupID, caID)
+}
+
+// SearchAccessGroups calls SearchAccessGroupsFunc.
+func (f *Fake) SearchAccessGroups(search *AccessGroupSearch, opts ...filters.Option) (*response.ResultSet[AccessGroup], error) {
+	if f.SearchAccessGroupsFunc == nil {
+		var r0 *response.ResultSet[AccessGroup]
+		return r0, errors.New("authorizer: Fake.SearchAccessGroups is not implemented")
+	}
+	return f.SearchAccessGroupsFunc(search, opts...)
+}
+
+// SearchAccountSecrets calls SearchAccountSecretsFunc.
+func (f *Fake) SearchAccountSecrets(search *AccountSecretSearch, opts ...filters.Option) (*response.ResultSet[HostAccountSecret], error) {
+	if f.SearchAccountSecretsFunc == nil {
+		var r0 *response.ResultSet[HostAccountSecret]
+		return r0, errors.New("authorizer: Fake.SearchAccountSecrets is not implemented")
+	}
+	return f.SearchAccountSecretsFunc(search, opts...)
+}
+
+// SearchCerts calls SearchCertsFunc.
+func (f *Fake) SearchCerts(search *ApiCertificateSearch, opts ...filters.Option) (*response.ResultSet[ApiCertificate], error) {
+	if f.SearchCertsFunc == nil {
+		var r0 *response.ResultSet[ApiCertificate]
+		return r0, errors.New("authorizer: Fake.SearchCerts is not implemented")
+	}
+	return f.SearchCertsFunc(search, opts...)
+}
+
+// SignPrincipalKey calls SignPrincipalKeyFunc.
+func (f *Fake) SignPrincipalKey(groupID string, sign *PrincipalKeySign, opts ...filters.Option) (*Signature, error) {
+	if f.SignPrincipalKeyFunc == nil {
+		var r0 *Signature
+		return r0, errors.New("authorizer: Fake.SignPrincipalKey is not implemented")
+	}
+	return f.SignPrincipalKeyFunc(groupID, sign, opts...)
+}
+
+// Status calls StatusFunc.
+func (f *Fake) Status() (*response.ServiceStatus, error) {
+	if f.StatusFunc == nil {
+		var r0 *response.ServiceStatus
+		return r0, errors.New("authorizer: Fake.Status is not implemented")
+	}
+	return f.StatusFunc()
+}
+
+// UpdateAccessGroup calls UpdateAccessGroupFunc.
+func (f *Fake) UpdateAccessGroup(accessGroupID string, update *AccessGroup) error {
+	if f.UpdateAccessGroupFunc == nil {
+		return errors.New("authorizer: Fake.UpdateAccessGroup is not implemented")
+	}
+	return f.UpdateAccessGroupFunc(accessGroupID, update)
+}
+
+// UpdateCAConfig calls UpdateCAConfigFunc.
+func (f *Fake) UpdateCAConfig(caType string, caConf ComponentCaConfig) error {
+	if f.UpdateCAConfigFunc == nil {
+		return errors.New("authorizer: Fake.UpdateCAConfig is not implemented")
+	}
+	return f.UpdateCAConfigFunc(caType, caConf)
+}
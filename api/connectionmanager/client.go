@@ -6,6 +6,8 @@
 
 package connectionmanager
 
+//go:generate go run github.com/SSHcom/privx-sdk-go/v2/internal/mockgen
+
 import (
 	"net/url"
 
@@ -53,8 +55,8 @@ func (c *ConnectionManager) GetConnections(opts ...filters.Option) (*response.Re
 		filters.SetCustomParams("verbose", "true"),
 	}, opts...)
 
-	for _, opt := range options {
-		opt(&params)
+	if err := filters.Apply(&params, options...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -81,8 +83,8 @@ func (c *ConnectionManager) SearchConnections(search *ConnectionSearch, opts ...
 		filters.SetCustomParams("verbose", "true"),
 	}, opts...)
 
-	for _, opt := range options {
-		opt(&params)
+	if err := filters.Apply(&params, options...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -106,8 +108,8 @@ func (c *ConnectionManager) GetConnection(connID string, opts ...filters.Option)
 		filters.SetCustomParams("verbose", "true"),
 	}, opts...)
 
-	for _, opt := range options {
-		opt(&params)
+	if err := filters.Apply(&params, options...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -154,8 +156,8 @@ func (c *ConnectionManager) CreateSessionForTrailLogDownload(connID, chanID stri
 func (c *ConnectionManager) DownloadTrailLog(connID, chanID, sessionID, filename string, opts ...filters.Option) error {
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return err
 	}
 
 	err := c.api.
@@ -218,8 +220,8 @@ func (c *ConnectionManager) GetConnectionTags(opts ...filters.Option) (*response
 	tags := &response.ResultSet[string]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -381,8 +383,8 @@ func (c *ConnectionManager) TrainUebaDataset(datasetID string, opts ...filters.O
 	count := ConnectionCount{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return ConnectionCount{}, err
 	}
 
 	_, err := c.api.
@@ -12,6 +12,7 @@ import (
 	"github.com/SSHcom/privx-sdk-go/v2/api/apiproxy"
 	"github.com/SSHcom/privx-sdk-go/v2/api/hoststore"
 	"github.com/SSHcom/privx-sdk-go/v2/api/networkaccessmanager"
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
 	"github.com/SSHcom/privx-sdk-go/v2/api/rolestore"
 )
 
@@ -49,8 +50,8 @@ type Connection struct {
 	TargetNetworkData *networkaccessmanager.NetworkTarget `json:"target_network_data,omitempty"`
 	TargetAPIData     *apiproxy.ApiTarget                 `json:"target_api_data,omitempty"`
 	RemoteAddress     string                              `json:"remote_address,omitempty"`
-	Connected         string                              `json:"connected,omitempty"`
-	Disconnected      string                              `json:"disconnected,omitempty"`
+	Connected         response.Timestamp                  `json:"connected,omitzero"`
+	Disconnected      response.Timestamp                  `json:"disconnected,omitzero"`
 	Duration          int32                               `json:"duration,omitempty"`
 	Status            string                              `json:"status,omitempty"`
 	LastActivity      string                              `json:"last_activity,omitempty"`
@@ -58,8 +59,8 @@ type Connection struct {
 	BytesOut          int64                               `json:"bytes_out,omitempty"`
 	ForceDisconnect   string                              `json:"force_disconnect,omitempty"`
 	TerminationReason string                              `json:"termination_reason,omitempty"`
-	Created           string                              `json:"created,omitempty"`
-	Updated           string                              `json:"updated,omitempty"`
+	Created           response.Timestamp                  `json:"created,omitzero"`
+	Updated           response.Timestamp                  `json:"updated,omitzero"`
 	UpdatedBy         string                              `json:"updated_by,omitempty"`
 	AuditEnabled      bool                                `json:"audit_enabled,omitempty"`
 	TrailID           string                              `json:"trail_id,omitempty"`
@@ -205,10 +206,10 @@ type ConnectionCount struct {
 
 // UebaModelInstance ueba model instance definition.
 type UebaModelInstance struct {
-	ID                string `json:"id"`
-	FeatureConfigName string `json:"feature_config_name"`
-	Status            string `json:"status"`
-	Created           string `json:"created"`
+	ID                string             `json:"id"`
+	FeatureConfigName string             `json:"feature_config_name"`
+	Status            string             `json:"status"`
+	Created           response.Timestamp `json:"created"`
 }
 
 // UebaInternalStatus ueba internal status definition.
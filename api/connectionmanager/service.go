@@ -0,0 +1,416 @@
+// Code generated by mockgen from ConnectionManager method set; DO NOT EDIT.
+
+package connectionmanager
+
+import (
+	"errors"
+
+	"github.com/SSHcom/privx-sdk-go/v2/api/filters"
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
+)
+
+// Service is the interface of ConnectionManager client, it allows substitution of
+// the client in tests, see Fake.
+type Service interface {
+	// CreateSessionForFileDownload create session id for trail stored file download.
+	CreateSessionForFileDownload(connID string, chanID string, fileID string) (DownloadSessionID, error)
+	// CreateSessionForTrailLogDownload create session id for trail log download.
+	CreateSessionForTrailLogDownload(connID string, chanID string) (DownloadSessionID, error)
+	// CreateSessionForUebaScriptDownload create session id for ueba setup script download.
+	CreateSessionForUebaScriptDownload() (response.Identifier, error)
+	// CreateUebaAnomalySettings create Ueba anomaly settings.
+	CreateUebaAnomalySettings(settings UebaAnomalySettings) error
+	// CreateUebaDataset create a new dataset.
+	CreateUebaDataset(dataset *Dataset) (response.Identifier, error)
+	// DeleteUebaDataset delete ueba dataset.
+	DeleteUebaDataset(datasetID string) error
+	// DownloadTrailLog download trail log of audited connection channel.
+	DownloadTrailLog(connID string, chanID string, sessionID string, filename string, opts ...filters.Option) error
+	// DownloadTrailStoredFile download trail stored file transferred within audited connection channel,
+	DownloadTrailStoredFile(connID string, chanID string, fileID string, sessionID string, filename string) error
+	// DownloadUebaScript download ueba setup script.
+	DownloadUebaScript(sessionID string, filename string) error
+	// GetAccessRoles get access roles for connection by id.
+	// Note, the v1 endpoint doesn't return the count as part of the response body,
+	// this will change with v2. Until then, we will handle it internally within the SDK.
+	GetAccessRoles(connID string) (*response.ResultSet[ConnectionPermission], error)
+	// GetConnection get connection by id.
+	// Query parameter "verbose" supports the following values:
+	// "true", return unfiltered data as before.
+	// "false", drop user_roles (duplicated by user snapshot data), the snapshot contains only relevant roles.
+	GetConnection(connID string, opts ...filters.Option) (*Connection, error)
+	// GetConnectionTags get connection tags.
+	GetConnectionTags(opts ...filters.Option) (*response.ResultSet[string], error)
+	// GetConnections get connections.
+	// Query parameter "verbose" supports the following values:
+	// "true", return unfiltered data as before.
+	// "false", drop user_roles (duplicated by user snapshot data), the snapshot contains only relevant roles.
+	GetConnections(opts ...filters.Option) (*response.ResultSet[Connection], error)
+	// GetUebaAnomalySettings get ueba anomaly settings.
+	GetUebaAnomalySettings() (UebaAnomalySettings, error)
+	// GetUebaConfigurations get ueba configurations.
+	GetUebaConfigurations() (*UebaConfigurations, error)
+	// GetUebaConnectionCounts get number of connections for dataset.
+	GetUebaConnectionCounts(timeRange TimeRange) (ConnectionCount, error)
+	// GetUebaDataset get ueba dataset by id.
+	GetUebaDataset(datasetID string) (*Dataset, error)
+	// GetUebaDatasets get dataset list for ueba.
+	GetUebaDatasets() (*response.ResultSet[Dataset], error)
+	// GetUebaStatus get ueba service status.
+	GetUebaStatus() (*response.ServiceStatus, error)
+	// GrantAccessRole grant a role permission for a connection.
+	GrantAccessRole(connID string, roleID string) error
+	// RevokeAccessRole revoke a permission for a role from a connection.
+	RevokeAccessRole(connID string, roleID string) error
+	// RevokeAccessRoleFromAllConnections revoke permissions for a role from all connections.
+	RevokeAccessRoleFromAllConnections(roleID string) error
+	// SearchConnections search for connections.
+	// Query parameter "verbose" supports the following values:
+	// "true", return unfiltered data as before.
+	// "false", drop user_roles (duplicated by user snapshot data), the snapshot contains only relevant roles.
+	SearchConnections(search *ConnectionSearch, opts ...filters.Option) (*response.ResultSet[Connection], error)
+	// SetUebaConfigurations set ueba configurations.
+	SetUebaConfigurations(configurations *UebaConfigurations) error
+	// StartUebaAnalyzing start ueba analyzing connections with a saved dataset.
+	StartUebaAnalyzing(datasetID string) error
+	// Status get connection manager microservice status.
+	Status() (*response.ServiceStatus, error)
+	// StopUebaAnalyzing stop ueba analyzing connection anomalies.
+	StopUebaAnalyzing() error
+	// TerminateConnection terminate connection by id.
+	TerminateConnection(connID string) error
+	// TerminateConnectionsByHost terminate connections from host.
+	TerminateConnectionsByHost(hostID string) error
+	// TerminateConnectionsByUser terminate connection(s) of a user
+	TerminateConnectionsByUser(userID string) error
+	// TrainUebaDataset train or retrain ueba dataset.
+	TrainUebaDataset(datasetID string, opts ...filters.Option) (ConnectionCount, error)
+	// UpdateConnectionTags update connection tags.
+	UpdateConnectionTags(tags []string, connectionID string) error
+	// UpdateUebaDataset update ueba dataset.
+	UpdateUebaDataset(dataset *Dataset, datasetID string) error
+}
+
+var (
+	_ Service = (*ConnectionManager)(nil)
+	_ Service = (*Fake)(nil)
+)
+
+// Fake is a fake of ConnectionManager client. Methods delegate to the corresponding
+// function fields, undefined functions return an error.
+type Fake struct {
+	CreateSessionForFileDownloadFunc       func(string, string, string) (DownloadSessionID, error)
+	CreateSessionForTrailLogDownloadFunc   func(string, string) (DownloadSessionID, error)
+	CreateSessionForUebaScriptDownloadFunc func() (response.Identifier, error)
+	CreateUebaAnomalySettingsFunc          func(UebaAnomalySettings) error
+	CreateUebaDatasetFunc                  func(*Dataset) (response.Identifier, error)
+	DeleteUebaDatasetFunc                  func(string) error
+	DownloadTrailLogFunc                   func(string, string, string, string, ...filters.Option) error
+	DownloadTrailStoredFileFunc            func(string, string, string, string, string) error
+	DownloadUebaScriptFunc                 func(string, string) error
+	GetAccessRolesFunc                     func(string) (*response.ResultSet[ConnectionPermission], error)
+	GetConnectionFunc                      func(string, ...filters.Option) (*Connection, error)
+	GetConnectionTagsFunc                  func(...filters.Option) (*response.ResultSet[string], error)
+	GetConnectionsFunc                     func(...filters.Option) (*response.ResultSet[Connection], error)
+	GetUebaAnomalySettingsFunc             func() (UebaAnomalySettings, error)
+	GetUebaConfigurationsFunc              func() (*UebaConfigurations, error)
+	GetUebaConnectionCountsFunc            func(TimeRange) (ConnectionCount, error)
+	GetUebaDatasetFunc                     func(string) (*Dataset, error)
+	GetUebaDatasetsFunc                    func() (*response.ResultSet[Dataset], error)
+	GetUebaStatusFunc                      func() (*response.ServiceStatus, error)
+	GrantAccessRoleFunc                    func(string, string) error
+	RevokeAccessRoleFunc                   func(string, string) error
+	RevokeAccessRoleFromAllConnectionsFunc func(string) error
+	SearchConnectionsFunc                  func(*ConnectionSearch, ...filters.Option) (*response.ResultSet[Connection], error)
+	SetUebaConfigurationsFunc              func(*UebaConfigurations) error
+	StartUebaAnalyzingFunc                 func(string) error
+	StatusFunc                             func() (*response.ServiceStatus, error)
+	StopUebaAnalyzingFunc                  func() error
+	TerminateConnectionFunc                func(string) error
+	TerminateConnectionsByHostFunc         func(string) error
+	TerminateConnectionsByUserFunc         func(string) error
+	TrainUebaDatasetFunc                   func(string, ...filters.Option) (ConnectionCount, error)
+	UpdateConnectionTagsFunc               func([]string, string) error
+	UpdateUebaDatasetFunc                  func(*Dataset, string) error
+}
+
+// CreateSessionForFileDownload calls CreateSessionForFileDownloadFunc.
+func (f *Fake) CreateSessionForFileDownload(connID string, chanID string, fileID string) (DownloadSessionID, error) {
+	if f.CreateSessionForFileDownloadFunc == nil {
+		var r0 DownloadSessionID
+		return r0, errors.New("connectionmanager: Fake.CreateSessionForFileDownload is not implemented")
+	}
+	return f.CreateSessionForFileDownloadFunc(connID, chanID, fileID)
+}
+
+// CreateSessionForTrailLogDownload calls CreateSessionForTrailLogDownloadFunc.
+func (f *Fake) CreateSessionForTrailLogDownload(connID string, chanID string) (DownloadSessionID, error) {
+	if f.CreateSessionForTrailLogDownloadFunc == nil {
+		var r0 DownloadSessionID
+		return r0, errors.New("connectionmanager: Fake.CreateSessionForTrailLogDownload is not implemented")
+	}
+	return f.CreateSessionForTrailLogDownloadFunc(connID, chanID)
+}
+
+// CreateSessionForUebaScriptDownload calls CreateSessionForUebaScriptDownloadFunc.
+func (f *Fake) CreateSessionForUebaScriptDownload() (response.Identifier, error) {
+	if f.CreateSessionForUebaScriptDownloadFunc == nil {
+		var r0 response.Identifier
+		return r0, errors.New("connectionmanager: Fake.CreateSessionForUebaScriptDownload is not implemented")
+	}
+	return f.CreateSessionForUebaScriptDownloadFunc()
+}
+
+// CreateUebaAnomalySettings calls CreateUebaAnomalySettingsFunc.
+func (f *Fake) CreateUebaAnomalySettings(settings UebaAnomalySettings) error {
+	if f.CreateUebaAnomalySettingsFunc == nil {
+		return errors.New("connectionmanager: Fake.CreateUebaAnomalySettings is not implemented")
+	}
+	return f.CreateUebaAnomalySettingsFunc(settings)
+}
+
+// CreateUebaDataset calls CreateUebaDatasetFunc.
+func (f *Fake) CreateUebaDataset(dataset *Dataset) (response.Identifier, error) {
+	if f.CreateUebaDatasetFunc == nil {
+		var r0 response.Identifier
+		return r0, errors.New("connectionmanager: Fake.CreateUebaDataset is not implemented")
+	}
+	return f.CreateUebaDatasetFunc(dataset)
+}
+
+// DeleteUebaDataset calls DeleteUebaDatasetFunc.
+func (f *Fake) DeleteUebaDataset(datasetID string) error {
+	if f.DeleteUebaDatasetFunc == nil {
+		return errors.New("connectionmanager: Fake.DeleteUebaDataset is not implemented")
+	}
+	return f.DeleteUebaDatasetFunc(datasetID)
+}
+
+// DownloadTrailLog calls DownloadTrailLogFunc.
+func (f *Fake) DownloadTrailLog(connID string, chanID string, sessionID string, filename string, opts ...filters.Option) error {
+	if f.DownloadTrailLogFunc == nil {
+		return errors.New("connectionmanager: Fake.DownloadTrailLog is not implemented")
+	}
+	return f.DownloadTrailLogFunc(connID, chanID, sessionID, filename, opts...)
+}
+
+// DownloadTrailStoredFile calls DownloadTrailStoredFileFunc.
+func (f *Fake) DownloadTrailStoredFile(connID string, chanID string, fileID string, sessionID string, filename string) error {
+	if f.DownloadTrailStoredFileFunc == nil {
+		return errors.New("connectionmanager: Fake.DownloadTrailStoredFile is not implemented")
+	}
+	return f.DownloadTrailStoredFileFunc(connID, chanID, fileID, sessionID, filename)
+}
+
+// DownloadUebaScript calls DownloadUebaScriptFunc.
+func (f *Fake) DownloadUebaScript(sessionID string, filename string) error {
+	if f.DownloadUebaScriptFunc == nil {
+		return errors.New("connectionmanager: Fake.DownloadUebaScript is not implemented")
+	}
+	return f.DownloadUebaScriptFunc(sessionID, filename)
+}
+
+// GetAccessRoles calls GetAccessRolesFunc.
+func (f *Fake) GetAccessRoles(connID string) (*response.ResultSet[ConnectionPermission], error) {
+	if f.GetAccessRolesFunc == nil {
+		var r0 *response.ResultSet[ConnectionPermission]
+		return r0, errors.New("connectionmanager: Fake.GetAccessRoles is not implemented")
+	}
+	return f.GetAccessRolesFunc(connID)
+}
+
+// GetConnection calls GetConnectionFunc.
+func (f *Fake) GetConnection(connID string, opts ...filters.Option) (*Connection, error) {
+	if f.GetConnectionFunc == nil {
+		var r0 *Connection
+		return r0, errors.New("connectionmanager: Fake.GetConnection is not implemented")
+	}
+	return f.GetConnectionFunc(connID, opts...)
+}
+
+// GetConnectionTags calls GetConnectionTagsFunc.
+func (f *Fake) GetConnectionTags(opts ...filters.Option) (*response.ResultSet[string], error) {
+	if f.GetConnectionTagsFunc == nil {
+		var r0 *response.ResultSet[string]
+		return r0, errors.New("connectionmanager: Fake.GetConnectionTags is not implemented")
+	}
+	return f.GetConnectionTagsFunc(opts...)
+}
+
+// GetConnections calls GetConnectionsFunc.
+func (f *Fake) GetConnections(opts ...filters.Option) (*response.ResultSet[Connection], error) {
+	if f.GetConnectionsFunc == nil {
+		var r0 *response.ResultSet[Connection]
+		return r0, errors.New("connectionmanager: Fake.GetConnections is not implemented")
+	}
+	return f.GetConnectionsFunc(opts...)
+}
+
+// GetUebaAnomalySettings calls GetUebaAnomalySettingsFunc.
+func (f *Fake) GetUebaAnomalySettings() (UebaAnomalySettings, error) {
+	if f.GetUebaAnomalySettingsFunc == nil {
+		var r0 UebaAnomalySettings
+		return r0, errors.New("connectionmanager: Fake.GetUebaAnomalySettings is not implemented")
+	}
+	return f.GetUebaAnomalySettingsFunc()
+}
+
+// GetUebaConfigurations calls GetUebaConfigurationsFunc.
+func (f *Fake) GetUebaConfigurations() (*UebaConfigurations, error) {
+	if f.GetUebaConfigurationsFunc == nil {
+		var r0 *UebaConfigurations
+		return r0, errors.New("connectionmanager: Fake.GetUebaConfigurations is not implemented")
+	}
+	return f.GetUebaConfigurationsFunc()
+}
+
+// GetUebaConnectionCounts calls GetUebaConnectionCountsFunc.
+func (f *Fake) GetUebaConnectionCounts(timeRange TimeRange) (ConnectionCount, error) {
+	if f.GetUebaConnectionCountsFunc == nil {
+		var r0 ConnectionCount
+		return r0, errors.New("connectionmanager: Fake.GetUebaConnectionCounts is not implemented")
+	}
+	return f.GetUebaConnectionCountsFunc(timeRange)
+}
+
+// GetUebaDataset calls GetUebaDatasetFunc.
+func (f *Fake) GetUebaDataset(datasetID string) (*Dataset, error) {
+	if f.GetUebaDatasetFunc == nil {
+		var r0 *Dataset
+		return r0, errors.New("connectionmanager: Fake.GetUebaDataset is not implemented")
+	}
+	return f.GetUebaDatasetFunc(datasetID)
+}
+
+// GetUebaDatasets calls GetUebaDatasetsFunc.
+func (f *Fake) GetUebaDatasets() (*response.ResultSet[Dataset], error) {
+	if f.GetUebaDatasetsFunc == nil {
+		var r0 *response.ResultSet[Dataset]
+		return r0, errors.New("connectionmanager: Fake.GetUebaDatasets is not implemented")
+	}
+	return f.GetUebaDatasetsFunc()
+}
+
+// GetUebaStatus calls GetUebaStatusFunc.
+func (f *Fake) GetUebaStatus() (*response.ServiceStatus, error) {
+	if f.GetUebaStatusFunc == nil {
+		var r0 *response.ServiceStatus
+		return r0, errors.New("connectionmanager: Fake.GetUebaStatus is not implemented")
+	}
+	return f.GetUebaStatusFunc()
+}
+
+// GrantAccessRole calls GrantAccessRoleFunc.
+func (f *Fake) GrantAccessRole(connID string, roleID string) error {
+	if f.GrantAccessRoleFunc == nil {
+		return errors.New("connectionmanager: Fake.GrantAccessRole is not implemented")
+	}
+	return f.GrantAccessRoleFunc(connID, roleID)
+}
+
+// RevokeAccessRole calls RevokeAccessRoleFunc.
+func (f *Fake) RevokeAccessRole(connID string, roleID string) error {
+	if f.RevokeAccessRoleFunc == nil {
+		return errors.New("connectionmanager: Fake.RevokeAccessRole is not implemented")
+	}
+	return f.RevokeAccessRoleFunc(connID, roleID)
+}
+
+// RevokeAccessRoleFromAllConnections calls RevokeAccessRoleFromAllConnectionsFunc.
+func (f *Fake) RevokeAccessRoleFromAllConnections(roleID string) error {
+	if f.RevokeAccessRoleFromAllConnectionsFunc == nil {
+		return errors.New("connectionmanager: Fake.RevokeAccessRoleFromAllConnections is not implemented")
+	}
+	return f.RevokeAccessRoleFromAllConnectionsFunc(roleID)
+}
+
+// SearchConnections calls SearchConnectionsFunc.
+func (f *Fake) SearchConnections(search *ConnectionSearch, opts ...filters.Option) (*response.ResultSet[Connection], error) {
+	if f.SearchConnectionsFunc == nil {
+		var r0 *response.ResultSet[Connection]
+		return r0, errors.New("connectionmanager: Fake.SearchConnections is not implemented")
+	}
+	return f.SearchConnectionsFunc(search, opts...)
+}
+
+// SetUebaConfigurations calls SetUebaConfigurationsFunc.
+func (f *Fake) SetUebaConfigurations(configurations *UebaConfigurations) error {
+	if f.SetUebaConfigurationsFunc == nil {
+		return errors.New("connectionmanager: Fake.SetUebaConfigurations is not implemented")
+	}
+	return f.SetUebaConfigurationsFunc(configurations)
+}
+
+// StartUebaAnalyzing calls StartUebaAnalyzingFunc.
+func (f *Fake) StartUebaAnalyzing(datasetID string) error {
+	if f.StartUebaAnalyzingFunc == nil {
+		return errors.New("connectionmanager: Fake.StartUebaAnalyzing is not implemented")
+	}
+	return f.StartUebaAnalyzingFunc(datasetID)
+}
+
+// Status calls StatusFunc.
+func (f *Fake) Status() (*response.ServiceStatus, error) {
+	if f.StatusFunc == nil {
+		var r0 *response.ServiceStatus
+		return r0, errors.New("connectionmanager: Fake.Status is not implemented")
+	}
+	return f.StatusFunc()
+}
+
+// StopUebaAnalyzing calls StopUebaAnalyzingFunc.
+func (f *Fake) StopUebaAnalyzing() error {
+	if f.StopUebaAnalyzingFunc == nil {
+		return errors.New("connectionmanager: Fake.StopUebaAnalyzing is not implemented")
+	}
+	return f.StopUebaAnalyzingFunc()
+}
+
+// TerminateConnection calls TerminateConnectionFunc.
+func (f *Fake) TerminateConnection(connID string) error {
+	if f.TerminateConnectionFunc == nil {
+		return errors.New("connectionmanager: Fake.TerminateConnection is not implemented")
+	}
+	return f.TerminateConnectionFunc(connID)
+}
+
+// TerminateConnectionsByHost calls TerminateConnectionsByHostFunc.
+func (f *Fake) TerminateConnectionsByHost(hostID string) error {
+	if f.TerminateConnectionsByHostFunc == nil {
+		return errors.New("connectionmanager: Fake.TerminateConnectionsByHost is not implemented")
+	}
+	return f.TerminateConnectionsByHostFunc(hostID)
+}
+
+// TerminateConnectionsByUser calls TerminateConnectionsByUserFunc.
+func (f *Fake) TerminateConnectionsByUser(userID string) error {
+	if f.TerminateConnectionsByUserFunc == nil {
+		return errors.New("connectionmanager: Fake.TerminateConnectionsByUser is not implemented")
+	}
+	return f.TerminateConnectionsByUserFunc(userID)
+}
+
+// TrainUebaDataset calls TrainUebaDatasetFunc.
+func (f *Fake) TrainUebaDataset(datasetID string, opts ...filters.Option) (ConnectionCount, error) {
+	if f.TrainUebaDatasetFunc == nil {
+		var r0 ConnectionCount
+		return r0, errors.New("connectionmanager: Fake.TrainUebaDataset is not implemented")
+	}
+	return f.TrainUebaDatasetFunc(datasetID, opts...)
+}
+
+// UpdateConnectionTags calls UpdateConnectionTagsFunc.
+func (f *Fake) UpdateConnectionTags(tags []string, connectionID string) error {
+	if f.UpdateConnectionTagsFunc == nil {
+		return errors.New("connectionmanager: Fake.UpdateConnectionTags is not implemented")
+	}
+	return f.UpdateConnectionTagsFunc(tags, connectionID)
+}
+
+// UpdateUebaDataset calls UpdateUebaDatasetFunc.
+func (f *Fake) UpdateUebaDataset(dataset *Dataset, datasetID string) error {
+	if f.UpdateUebaDatasetFunc == nil {
+		return errors.New("connectionmanager: Fake.UpdateUebaDataset is not implemented")
+	}
+	return f.UpdateUebaDatasetFunc(dataset, datasetID)
+}
@@ -6,6 +6,8 @@
 
 package dbproxy
 
+//go:generate go run github.com/SSHcom/privx-sdk-go/v2/internal/mockgen
+
 import (
 	"github.com/SSHcom/privx-sdk-go/v2/api/response"
 	"github.com/SSHcom/privx-sdk-go/v2/restapi"
@@ -6,15 +6,17 @@
 
 package dbproxy
 
+import "github.com/SSHcom/privx-sdk-go/v2/api/response"
+
 // DBProxyCACertificateInfo db proxy x509 CA certificate definition.
 type DBProxyCACertificateInfo struct {
-	Subject           string `json:"subject,omitempty"`
-	Issuer            string `json:"issuer,omitempty"`
-	Serial            string `json:"serial,omitempty"`
-	NotBefore         string `json:"not_before,omitempty"`
-	NotAfter          string `json:"not_after,omitempty"`
-	FingerPrintSHA1   string `json:"fingerprint_sha1,omitempty"`
-	FingerPrintSHA256 string `json:"fingerprint_sha256,omitempty"`
+	Subject           string             `json:"subject,omitempty"`
+	Issuer            string             `json:"issuer,omitempty"`
+	Serial            string             `json:"serial,omitempty"`
+	NotBefore         response.Timestamp `json:"not_before,omitzero"`
+	NotAfter          response.Timestamp `json:"not_after,omitzero"`
+	FingerPrintSHA1   string             `json:"fingerprint_sha1,omitempty"`
+	FingerPrintSHA256 string             `json:"fingerprint_sha256,omitempty"`
 }
 
 // DBProxyAPIConf db proxy configuration definition.
@@ -0,0 +1,48 @@
+// Code generated by mockgen from DbProxy method set; DO NOT EDIT.
+
+package dbproxy
+
+import (
+	"errors"
+
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
+)
+
+// Service is the interface of DbProxy client, it allows substitution of
+// the client in tests, see Fake.
+type Service interface {
+	// GetDbProxyConfig get db proxy configuration.
+	GetDbProxyConfig() (*DBProxyAPIConf, error)
+	// Status get db proxy microservice status.
+	Status() (*response.ServiceStatus, error)
+}
+
+var (
+	_ Service = (*DbProxy)(nil)
+	_ Service = (*Fake)(nil)
+)
+
+// Fake is a fake of DbProxy client. Methods delegate to the corresponding
+// function fields, undefined functions return an error.
+type Fake struct {
+	GetDbProxyConfigFunc func() (*DBProxyAPIConf, error)
+	StatusFunc           func() (*response.ServiceStatus, error)
+}
+
+// GetDbProxyConfig calls GetDbProxyConfigFunc.
+func (f *Fake) GetDbProxyConfig() (*DBProxyAPIConf, error) {
+	if f.GetDbProxyConfigFunc == nil {
+		var r0 *DBProxyAPIConf
+		return r0, errors.New("dbproxy: Fake.GetDbProxyConfig is not implemented")
+	}
+	return f.GetDbProxyConfigFunc()
+}
+
+// Status calls StatusFunc.
+func (f *Fake) Status() (*response.ServiceStatus, error) {
+	if f.StatusFunc == nil {
+		var r0 *response.ServiceStatus
+		return r0, errors.New("dbproxy: Fake.Status is not implemented")
+	}
+	return f.StatusFunc()
+}
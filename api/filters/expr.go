@@ -0,0 +1,183 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package filters
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Operators of filter expressions.
+const (
+	opEq  = "="
+	opNe  = "!="
+	opGt  = ">"
+	opLt  = "<"
+	opIn  = "IN"
+	opAnd = "AND"
+	opOr  = "OR"
+	opNot = "NOT"
+)
+
+/*
+Expr is a typed filter expression rendered to the filter query parameter.
+Fields are JSON names of the resource model, values are quoted and escaped.
+
+	expr := filters.And(
+		filters.Eq("cloud_provider", "AWS"),
+		filters.Or(filters.In("tags", "prod", "dmz"), filters.Gt("created", since)),
+	)
+*/
+type Expr struct {
+	op     string
+	field  string
+	values []any
+	args   []Expr
+}
+
+// Eq matches field equal to the value.
+func Eq(field string, value any) Expr {
+	return Expr{op: opEq, field: field, values: []any{value}}
+}
+
+// Ne matches field not equal to the value.
+func Ne(field string, value any) Expr {
+	return Expr{op: opNe, field: field, values: []any{value}}
+}
+
+// Gt matches field greater than the value.
+func Gt(field string, value any) Expr {
+	return Expr{op: opGt, field: field, values: []any{value}}
+}
+
+// Lt matches field less than the value.
+func Lt(field string, value any) Expr {
+	return Expr{op: opLt, field: field, values: []any{value}}
+}
+
+// In matches field to any of the values.
+func In(field string, values ...any) Expr {
+	return Expr{op: opIn, field: field, values: values}
+}
+
+// And matches all of the expressions.
+func And(exprs ...Expr) Expr {
+	return Expr{op: opAnd, args: exprs}
+}
+
+// Or matches any of the expressions.
+func Or(exprs ...Expr) Expr {
+	return Expr{op: opOr, args: exprs}
+}
+
+// Not negates the expression.
+func Not(expr Expr) Expr {
+	return Expr{op: opNot, args: []Expr{expr}}
+}
+
+// String renders the expression without validation of fields.
+func (e Expr) String() string {
+	s, err := e.render(nil)
+	if err != nil {
+		return ""
+	}
+	return s
+}
+
+// render renders the expression, fields and values are validated against
+// the fields unless fields are nil
+func (e Expr) render(fields map[string]reflect.Type) (string, error) {
+	switch e.op {
+	case opAnd, opOr:
+		if len(e.args) == 0 {
+			return "", fmt.Errorf("filter %s requires expressions", e.op)
+		}
+		parts := make([]string, len(e.args))
+		for i, arg := range e.args {
+			s, err := arg.render(fields)
+			if err != nil {
+				return "", err
+			}
+			parts[i] = s
+		}
+		if len(parts) == 1 {
+			return parts[0], nil
+		}
+		return "(" + strings.Join(parts, " "+e.op+" ") + ")", nil
+
+	case opNot:
+		s, err := e.args[0].render(fields)
+		if err != nil {
+			return "", err
+		}
+		return "NOT " + s, nil
+
+	case "":
+		return "", fmt.Errorf("empty filter expression")
+	}
+
+	if fields != nil {
+		if _, ok := fields[e.field]; !ok {
+			return "", fmt.Errorf("unknown filter field %s", e.field)
+		}
+	}
+	if len(e.values) == 0 {
+		return "", fmt.Errorf("filter field %s requires values", e.field)
+	}
+
+	values := make([]string, len(e.values))
+	for i, value := range e.values {
+		if fields != nil {
+			if err := checkValue(fields[e.field], value); err != nil {
+				return "", fmt.Errorf("invalid value of filter field %s: %w", e.field, err)
+			}
+		}
+		s, err := filterValue(value)
+		if err != nil {
+			return "", err
+		}
+		values[i] = s
+	}
+
+	if e.op == opIn {
+		return e.field + " IN (" + strings.Join(values, ", ") + ")", nil
+	}
+	return e.field + " " + e.op + " " + values[0], nil
+}
+
+// filterValue renders value of expression, strings and times are quoted
+func filterValue(value any) (string, error) {
+	if t, ok := value.(time.Time); ok {
+		value = t.UTC().Format(time.RFC3339)
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// checkValue checks that value is assignable to the field, slice fields
+// accept values of their elements
+func checkValue(t reflect.Type, value any) error {
+	if t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8 {
+		t = t.Elem()
+	}
+	if t, ok := value.(time.Time); ok {
+		value = t.UTC().Format(time.RFC3339)
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, reflect.New(t).Interface())
+}
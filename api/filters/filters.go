@@ -1,6 +1,7 @@
 package filters
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
 	"strconv"
@@ -11,93 +12,112 @@ const (
 	DESC = "DESC" // DESC defines descending sort direction.
 )
 
-// Option function type setting url values.
-type Option func(*url.Values)
+// Option function type setting url values. Option fails if the values
+// cannot be set, the failure is returned by the API call.
+type Option func(*url.Values) error
+
+// Apply sets url values of options, it returns errors of failed options.
+func Apply(v *url.Values, opts ...Option) error {
+	var errs []error
+	for _, opt := range opts {
+		if err := opt(v); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
 
 // Offset sets the offset for url values.
 func Offset(offset int) Option {
-	return func(v *url.Values) { v.Set("offset", strconv.Itoa(offset)) }
+	return func(v *url.Values) error {
+		v.Set("offset", strconv.Itoa(offset))
+		return nil
+	}
 }
 
 // Limit sets the limit for url values.
 func Limit(limit int) Option {
-	return func(v *url.Values) { v.Set("limit", strconv.Itoa(limit)) }
+	return func(v *url.Values) error {
+		v.Set("limit", strconv.Itoa(limit))
+		return nil
+	}
 }
 
 // Paging sets both the offset and limit for url values.
 func Paging(offset, limit int) Option {
-	return func(v *url.Values) {
+	return func(v *url.Values) error {
 		v.Set("offset", strconv.Itoa(offset))
 		v.Set("limit", strconv.Itoa(limit))
+		return nil
 	}
 }
 
 // Sort sets the sort key and direction for url values.
 func Sort(key, dir string) Option {
-	return func(v *url.Values) {
+	return func(v *url.Values) error {
 		v.Set("sortkey", key)
 		v.Set("sortdir", dir)
+		return nil
 	}
 }
 
 // SortAsc sets the sort key with ascending order for url values.
 func SortAsc(key string) Option {
-	return func(v *url.Values) {
+	return func(v *url.Values) error {
 		v.Set("sortkey", key)
 		v.Set("sortdir", ASC)
+		return nil
 	}
 }
 
 // SortDesc sets the sort key with descending order for url values.
 func SortDesc(key string) Option {
-	return func(v *url.Values) {
+	return func(v *url.Values) error {
 		v.Set("sortkey", key)
 		v.Set("sortdir", DESC)
+		return nil
 	}
 }
 
 // Filter sets the filter for url values.
 func Filter(filter string) Option {
-	return func(v *url.Values) { v.Set("filter", filter) }
+	return func(v *url.Values) error {
+		v.Set("filter", filter)
+		return nil
+	}
 }
 
 // FuzzyCount sets the fuzzy count for url values.
 func FuzzyCount(fuzzycount bool) Option {
-	return func(v *url.Values) { v.Set("fuzzycount", strconv.FormatBool(fuzzycount)) }
+	return func(v *url.Values) error {
+		v.Set("fuzzycount", strconv.FormatBool(fuzzycount))
+		return nil
+	}
 }
 
 // SetCustomParams set custom key-value parameter pairs freely.
 func SetCustomParams(key, value string) Option {
-	return func(v *url.Values) { v.Set(key, value) }
+	return func(v *url.Values) error {
+		v.Set(key, value)
+		return nil
+	}
 }
 
 // SetStructParams convert struct to URL values and return Option from those values.
+// The option fails if the struct cannot be converted.
 func SetStructParams(p interface{}) Option {
-	return func(v *url.Values) {
+	return func(v *url.Values) error {
 		values, err := Values(p)
 		if err != nil {
-			fmt.Printf("failed converting struct into URL values: %v", err)
-			return
+			return fmt.Errorf("failed converting struct into URL values: %w", err)
 		}
 
-		options := urlValuesToOptions(values)
-		for _, opt := range options {
-			opt(v)
-		}
-	}
-}
-
-// urlValuesToOptions convert URL values into []Option.
-func urlValuesToOptions(values url.Values) []Option {
-	opts := make([]Option, 0)
-	for key, vals := range values {
-		for _, val := range vals {
-			opts = append(opts, func(k, v string) Option {
-				return func(params *url.Values) {
-					params.Set(k, v)
-				}
-			}(key, val))
+		for key, vals := range values {
+			v.Del(key)
+			for _, val := range vals {
+				v.Add(key, val)
+			}
 		}
+		return nil
 	}
-	return opts
 }
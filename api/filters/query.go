@@ -0,0 +1,301 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package filters
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+/*
+Query is a builder of search request T of PrivX resource R, e.g.
+hoststore.HostSearch of hoststore.Host. Search fields are JSON names of the
+search request fields, sort keys and filter expressions use JSON names of
+the resource fields. Unknown fields and values of wrong type fail the build.
+
+	search, opts, err := filters.NewQuery[hoststore.HostSearch, hoststore.Host]().
+		In("cloud_providers", "AWS", "AZURE").
+		Tags("production").
+		Keywords("web").
+		Where(filters.Eq("audit_enabled", true)).
+		Sort("created", filters.DESC).
+		Paging(0, 100).
+		Build()
+	if err != nil {
+		return err
+	}
+
+	hosts, err := store.SearchHosts(search, opts...)
+*/
+type Query[T, R any] struct {
+	fields   map[string]reflect.Type
+	resource map[string]reflect.Type
+	body     map[string]any
+	opts     []Option
+	filter   *string
+	where    []Expr
+	errs     []error
+}
+
+// NewQuery creates query builder of search request T of resource R.
+func NewQuery[T, R any]() *Query[T, R] {
+	q := &Query[T, R]{
+		fields:   map[string]reflect.Type{},
+		resource: map[string]reflect.Type{},
+		body:     map[string]any{},
+	}
+	searchFields(reflect.TypeFor[T](), q.fields)
+	searchFields(reflect.TypeFor[R](), q.resource)
+
+	return q
+}
+
+// Equals matches field to the value.
+func (q *Query[T, R]) Equals(field string, value any) *Query[T, R] {
+	t, ok := q.field(field)
+	if !ok {
+		return q
+	}
+
+	if t.Kind() == reflect.Slice {
+		return q.append(field, value)
+	}
+
+	q.body[field] = value
+	return q
+}
+
+// In matches field to any of the values.
+func (q *Query[T, R]) In(field string, values ...any) *Query[T, R] {
+	t, ok := q.field(field)
+	if !ok {
+		return q
+	}
+
+	if t.Kind() != reflect.Slice {
+		q.errs = append(q.errs, fmt.Errorf("search field %s does not accept multiple values", field))
+		return q
+	}
+
+	return q.append(field, values...)
+}
+
+// Range matches field to the range, zero bound leaves the range open.
+// The field shall define start and end of the range, e.g. timestamp search.
+func (q *Query[T, R]) Range(field string, start, end any) *Query[T, R] {
+	t, ok := q.field(field)
+	if !ok {
+		return q
+	}
+
+	if t.Kind() != reflect.Struct || !hasField(t, "start") || !hasField(t, "end") {
+		q.errs = append(q.errs, fmt.Errorf("search field %s is not a range", field))
+		return q
+	}
+
+	bounds := map[string]any{}
+	if !isZero(start) {
+		bounds["start"] = start
+	}
+	if !isZero(end) {
+		bounds["end"] = end
+	}
+
+	q.body[field] = bounds
+	return q
+}
+
+// Between matches timestamp field to the time window.
+func (q *Query[T, R]) Between(field string, from, to time.Time) *Query[T, R] {
+	return q.Range(field, timeValue(from), timeValue(to))
+}
+
+// Window matches the time window of search request, the request shall
+// define start_time and end_time fields.
+func (q *Query[T, R]) Window(from, to time.Time) *Query[T, R] {
+	if _, ok := q.field("start_time"); !ok {
+		return q
+	}
+	if _, ok := q.field("end_time"); !ok {
+		return q
+	}
+
+	if !from.IsZero() {
+		q.body["start_time"] = timeValue(from)
+	}
+	if !to.IsZero() {
+		q.body["end_time"] = timeValue(to)
+	}
+	return q
+}
+
+// Tags matches any of the tags.
+func (q *Query[T, R]) Tags(tags ...string) *Query[T, R] {
+	values := make([]any, len(tags))
+	for i, tag := range tags {
+		values[i] = tag
+	}
+
+	return q.In("tags", values...)
+}
+
+// Keywords matches free-form keywords.
+func (q *Query[T, R]) Keywords(keywords ...string) *Query[T, R] {
+	return q.Equals("keywords", strings.Join(keywords, " "))
+}
+
+// Sort orders results by the resource field in ASC or DESC direction.
+func (q *Query[T, R]) Sort(field, dir string) *Query[T, R] {
+	if _, ok := q.resource[field]; !ok {
+		q.errs = append(q.errs, fmt.Errorf("unknown sort field %s of %s", field, reflect.TypeFor[R]()))
+		return q
+	}
+
+	if dir != ASC && dir != DESC {
+		q.errs = append(q.errs, fmt.Errorf("invalid sort direction %q", dir))
+		return q
+	}
+
+	q.opts = append(q.opts, Sort(field, dir))
+	return q
+}
+
+// Paging sets offset and limit of results.
+func (q *Query[T, R]) Paging(offset, limit int) *Query[T, R] {
+	q.opts = append(q.opts, Paging(offset, limit))
+	return q
+}
+
+// Filter sets raw filter query parameter, e.g. "accessible". It cannot be
+// combined with Where.
+func (q *Query[T, R]) Filter(filter string) *Query[T, R] {
+	q.filter = &filter
+	return q
+}
+
+// Where matches filter expressions of the resource fields, multiple
+// expressions are combined with AND. Expressions are rendered to the filter
+// query parameter.
+func (q *Query[T, R]) Where(exprs ...Expr) *Query[T, R] {
+	q.where = append(q.where, exprs...)
+	return q
+}
+
+// Build renders the search request and query parameters.
+func (q *Query[T, R]) Build() (*T, []Option, error) {
+	if len(q.errs) > 0 {
+		return nil, nil, errors.Join(q.errs...)
+	}
+
+	opts := append([]Option{}, q.opts...)
+	switch {
+	case q.filter != nil && len(q.where) > 0:
+		return nil, nil, errors.New("raw filter cannot be combined with filter expressions")
+	case q.filter != nil:
+		opts = append(opts, Filter(*q.filter))
+	case len(q.where) > 0:
+		filter, err := And(q.where...).render(q.resource)
+		if err != nil {
+			return nil, nil, err
+		}
+		opts = append(opts, Filter(filter))
+	}
+
+	data, err := json.Marshal(q.body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	search := new(T)
+	if err := json.Unmarshal(data, search); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return nil, nil, fmt.Errorf("invalid value of search field %s: %s", typeErr.Field, typeErr.Value)
+		}
+		return nil, nil, err
+	}
+
+	return search, opts, nil
+}
+
+// field returns type of search field
+func (q *Query[T, R]) field(name string) (reflect.Type, bool) {
+	t, ok := q.fields[name]
+	if !ok {
+		q.errs = append(q.errs, fmt.Errorf("unknown search field %s of %s", name, reflect.TypeFor[T]()))
+	}
+	return t, ok
+}
+
+// append adds values to slice field
+func (q *Query[T, R]) append(field string, values ...any) *Query[T, R] {
+	existing, _ := q.body[field].([]any)
+	q.body[field] = append(existing, values...)
+	return q
+}
+
+// searchFields collects JSON names of struct fields
+func searchFields(t reflect.Type, fields map[string]reflect.Type) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			searchFields(f.Type, fields)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = strings.ToLower(f.Name)
+		}
+
+		ft := f.Type
+		for ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+		fields[name] = ft
+	}
+}
+
+// hasField checks if struct has field with JSON name
+func hasField(t reflect.Type, name string) bool {
+	fields := map[string]reflect.Type{}
+	searchFields(t, fields)
+	_, ok := fields[name]
+	return ok
+}
+
+// timeValue formats time as search value, zero time is nil
+func timeValue(t time.Time) any {
+	if t.IsZero() {
+		return nil
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// isZero checks if range bound is not defined
+func isZero(v any) bool {
+	return v == nil || reflect.ValueOf(v).IsZero()
+}
@@ -0,0 +1,118 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package filters
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type tRange struct {
+	Start string
+	End   string
+}
+
+type tSearch struct {
+	Keywords  string   `json:"keywords,omitempty"`
+	Name      string   `json:"name,omitempty"`
+	Port      []int    `json:"port,omitempty"`
+	Tags      []string `json:"tags,omitempty"`
+	Connected *tRange  `json:"connected,omitempty"`
+	StartTime string   `json:"start_time"`
+	EndTime   string   `json:"end_time"`
+}
+
+type tResource struct {
+	Name    string    `json:"name"`
+	Port    int       `json:"port"`
+	Tags    []string  `json:"tags"`
+	Created time.Time `json:"created"`
+}
+
+func TestQuery(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	search, opts, err := NewQuery[tSearch, tResource]().
+		Keywords("web", "db").
+		Equals("name", "x").
+		In("port", 22, 2222).
+		Tags("prod").
+		Between("connected", from, time.Time{}).
+		Window(from, from.Add(time.Hour)).
+		Sort("created", DESC).
+		Paging(10, 5).
+		Filter("accessible").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := &tSearch{
+		Keywords:  "web db",
+		Name:      "x",
+		Port:      []int{22, 2222},
+		Tags:      []string{"prod"},
+		Connected: &tRange{Start: "2024-01-01T00:00:00Z"},
+		StartTime: "2024-01-01T00:00:00Z",
+		EndTime:   "2024-01-01T01:00:00Z",
+	}
+	if !reflect.DeepEqual(search, expect) {
+		t.Errorf("unexpected search: %+v", search)
+	}
+
+	params := url.Values{}
+	if err := Apply(&params, opts...); err != nil {
+		t.Fatal(err)
+	}
+	if params.Encode() != "filter=accessible&limit=5&offset=10&sortdir=DESC&sortkey=created" {
+		t.Errorf("unexpected params: %s", params.Encode())
+	}
+}
+
+func TestQueryInvalid(t *testing.T) {
+	for name, q := range map[string]*Query[tSearch, tResource]{
+		"unknown field":  NewQuery[tSearch, tResource]().Equals("address", "x"),
+		"multiple":       NewQuery[tSearch, tResource]().In("name", "x", "y"),
+		"range":          NewQuery[tSearch, tResource]().Range("port", 1, 2),
+		"sort direction": NewQuery[tSearch, tResource]().Sort("name", "UP"),
+		"value type":     NewQuery[tSearch, tResource]().In("port", "ssh"),
+		"sort field":     NewQuery[tSearch, tResource]().Sort("keywords", ASC),
+		"filter field":   NewQuery[tSearch, tResource]().Where(Eq("address", "x")),
+		"filter value":   NewQuery[tSearch, tResource]().Where(In("port", 22, "ssh")),
+		"filter":         NewQuery[tSearch, tResource]().Filter("accessible").Where(Eq("name", "x")),
+	} {
+		if _, _, err := q.Build(); err == nil {
+			t.Errorf("%s: invalid query is built", name)
+		}
+	}
+}
+
+func TestQueryWhere(t *testing.T) {
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, opts, err := NewQuery[tSearch, tResource]().
+		Where(
+			Eq("name", `web "1"`),
+			Or(In("tags", "prod", "dmz"), Not(Lt("created", since))),
+			Ne("port", 22),
+		).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	params := url.Values{}
+	if err := Apply(&params, opts...); err != nil {
+		t.Fatal(err)
+	}
+	expect := `(name = "web \"1\"" AND (tags IN ("prod", "dmz") OR NOT created < "2024-01-01T00:00:00Z") AND port != 22)`
+	if filter := params.Get("filter"); filter != expect {
+		t.Errorf("unexpected filter: %s", filter)
+	}
+}
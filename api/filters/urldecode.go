@@ -0,0 +1,334 @@
+package filters
+
+import (
+	"encoding"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	decoderType         = reflect.TypeOf(new(Decoder)).Elem()
+	textUnmarshalerType = reflect.TypeOf(new(encoding.TextUnmarshaler)).Elem()
+)
+
+// Decoder is an interface implemented by any type that wishes to decode
+// itself from URL values in a non-standard way. It is the counterpart of Encoder.
+type Decoder interface {
+	DecodeValues(key string, v url.Values) error
+}
+
+// Decode populates struct from URL values by reflecting over its fields and
+// tags, it is the counterpart of Values. Fields missing from URL values are
+// left unchanged.
+func Decode(values url.Values, s interface{}) error {
+	v := reflect.ValueOf(s)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return fmt.Errorf("expected a pointer to struct, got: %T", s)
+	}
+
+	// Dereference pointers, allocate nil pointers.
+	v = v.Elem()
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return fmt.Errorf("expected a pointer to struct, got: %T", s)
+	}
+
+	_, err := decodeValue(values, v)
+
+	return err
+}
+
+// decodeValue recursively populates fields of a struct from URL values,
+// handling embedded structs, slices, arrays, and custom types. It reports
+// whether any field was found from URL values.
+func decodeValue(values url.Values, val reflect.Value) (bool, error) {
+	found := false
+	t := val.Type()
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		// skip unexported fields
+		if sf.PkgPath != "" && !sf.Anonymous {
+			continue
+		}
+
+		sv := val.Field(i)
+		tag := sf.Tag.Get("url")
+		// skip ignored fields
+		if tag == "-" {
+			continue
+		}
+
+		// split url tag into its base name and options
+		name, opts := parseTag(tag)
+		if name == "" {
+			// decode embedded struct fields from the same values
+			if sf.Anonymous && indirectType(sf.Type).Kind() == reflect.Struct {
+				ok, err := decodeNested(values, sv)
+				if err != nil {
+					return found, err
+				}
+				found = found || ok
+				continue
+			}
+
+			name = sf.Name
+		}
+
+		if !sv.CanSet() {
+			continue
+		}
+
+		// check if value implements Decoder interface for custom decoding
+		if reflect.PointerTo(sf.Type).Implements(decoderType) || sf.Type.Implements(decoderType) {
+			if !hasKey(values, name) {
+				continue
+			}
+
+			target := sv
+			if sv.Kind() == reflect.Ptr {
+				if sv.IsNil() {
+					sv.Set(reflect.New(sf.Type.Elem()))
+				}
+			} else {
+				target = sv.Addr()
+			}
+
+			if err := target.Interface().(Decoder).DecodeValues(name, values); err != nil {
+				return found, err
+			}
+			found = true
+			continue
+		}
+
+		ft := indirectType(sf.Type)
+		var (
+			ok  bool
+			err error
+		)
+
+		switch {
+		case ft.Kind() == reflect.Slice || ft.Kind() == reflect.Array:
+			ok, err = decodeSliceOrArray(values, name, sv, opts, sf)
+		case ft.Kind() == reflect.Struct && ft != timeType && !reflect.PointerTo(ft).Implements(textUnmarshalerType):
+			// handle recursively nested structs
+			ok, err = decodeNested(values, sv)
+		default:
+			vals, exists := values[name]
+			if !exists || len(vals) == 0 {
+				continue
+			}
+			ok, err = true, setValue(allocate(sv), vals[0], opts, sf)
+		}
+
+		if err != nil {
+			return found, fmt.Errorf("failed decoding %s: %w", name, err)
+		}
+		found = found || ok
+	}
+
+	return found, nil
+}
+
+// decodeNested decodes nested or embedded struct, nil pointer is allocated
+// only if any of the struct fields is found from URL values.
+func decodeNested(values url.Values, sv reflect.Value) (bool, error) {
+	if sv.Kind() != reflect.Ptr {
+		return decodeValue(values, sv)
+	}
+
+	if !sv.IsNil() {
+		return decodeValue(values, reflect.Indirect(sv))
+	}
+
+	if !sv.CanSet() {
+		return false, nil
+	}
+
+	nested := reflect.New(indirectType(sv.Type()))
+	found, err := decodeValue(values, nested.Elem())
+	if err != nil || !found {
+		return found, err
+	}
+
+	allocate(sv).Set(nested.Elem())
+	return true, nil
+}
+
+// decodeSliceOrArray processes slices/arrays in a struct field, either splitting
+// them with a delimiter or collecting each value separately from the URL values map.
+func decodeSliceOrArray(values url.Values, name string, sv reflect.Value, opts tagOptions, sf reflect.StructField) (bool, error) {
+	var (
+		delimiter string
+		items     []string
+	)
+
+	switch {
+	case opts.Contains("comma"):
+		delimiter = ","
+	case opts.Contains("space"):
+		delimiter = " "
+	case opts.Contains("semicolon"):
+		delimiter = ";"
+	case opts.Contains("brackets"):
+		name += "[]"
+	default:
+		delimiter = sf.Tag.Get("del")
+	}
+
+	switch {
+	case delimiter != "":
+		raw, exists := values[name]
+		if !exists || len(raw) == 0 {
+			return false, nil
+		}
+		if raw[0] != "" {
+			items = strings.Split(raw[0], delimiter)
+		}
+	case opts.Contains("numbered"):
+		for i := 0; ; i++ {
+			val, exists := values[fmt.Sprintf("%s%d", name, i)]
+			if !exists || len(val) == 0 {
+				break
+			}
+			items = append(items, val[0])
+		}
+		if len(items) == 0 {
+			return false, nil
+		}
+	default:
+		vals, exists := values[name]
+		if !exists {
+			return false, nil
+		}
+		items = vals
+	}
+
+	sv = allocate(sv)
+	if sv.Kind() == reflect.Slice {
+		sv.Set(reflect.MakeSlice(sv.Type(), len(items), len(items)))
+	} else if len(items) > sv.Len() {
+		return false, fmt.Errorf("%d values exceed array length %d", len(items), sv.Len())
+	}
+
+	for i, item := range items {
+		if err := setValue(allocate(sv.Index(i)), item, opts, sf); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// setValue parses string representation of a value based on its type and tag options.
+func setValue(v reflect.Value, s string, opts tagOptions, sf reflect.StructField) error {
+	if v.Type() == timeType {
+		t, err := parseTimeValue(s, opts, sf)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(t))
+		return nil
+	}
+
+	if reflect.PointerTo(v.Type()).Implements(textUnmarshalerType) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported type: %s", v.Type())
+	}
+
+	return nil
+}
+
+// parseTimeValue parses a time.Time value based on provided tag options or struct field tag.
+// Defaults to RFC3339 format if no specific option is provided.
+func parseTimeValue(s string, opts tagOptions, sf reflect.StructField) (time.Time, error) {
+	switch {
+	case opts.Contains("unix"), opts.Contains("unixmilli"), opts.Contains("unixnano"):
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		switch {
+		case opts.Contains("unix"):
+			return time.Unix(i, 0), nil
+		case opts.Contains("unixmilli"):
+			return time.UnixMilli(i), nil
+		default:
+			return time.Unix(0, i), nil
+		}
+	}
+
+	if layout := sf.Tag.Get("layout"); layout != "" {
+		return time.Parse(layout, s)
+	}
+	return time.Parse(time.RFC3339, s)
+}
+
+// allocate dereferences pointers, nil pointers are allocated.
+func allocate(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// indirectType returns the type pointers point to.
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
+
+// hasKey checks if URL values contains the key or keys derived from it.
+func hasKey(values url.Values, name string) bool {
+	for key := range values {
+		if strings.HasPrefix(key, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,112 @@
+package filters
+
+import (
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type tCSV []string
+
+func (c tCSV) EncodeValues(key string, v *url.Values) error {
+	v.Set(key, strings.Join(c, "|"))
+	return nil
+}
+
+func (c *tCSV) DecodeValues(key string, v url.Values) error {
+	*c = strings.Split(v.Get(key), "|")
+	return nil
+}
+
+type tEmbedded struct {
+	Page int `url:"page,omitempty"`
+}
+
+type tParams struct {
+	tEmbedded
+	Name     string    `url:"name,omitempty"`
+	Count    *int      `url:"count,omitempty"`
+	Enabled  bool      `url:"enabled,int"`
+	Ratio    float64   `url:"ratio"`
+	IDs      []string  `url:"id,comma"`
+	Ports    []uint16  `url:"port"`
+	Zones    []string  `url:"zone,brackets"`
+	Keys     [2]string `url:"key,numbered"`
+	Since    time.Time `url:"since,unix"`
+	Day      time.Time `url:"day" layout:"2006-01-02"`
+	Custom   tCSV      `url:"custom"`
+	Skipped  string    `url:"-"`
+	internal string
+}
+
+func TestDecode(t *testing.T) {
+	count := 3
+	params := tParams{
+		tEmbedded: tEmbedded{Page: 2},
+		Name:      "x",
+		Count:     &count,
+		Enabled:   true,
+		Ratio:     0.5,
+		IDs:       []string{"a", "b"},
+		Ports:     []uint16{22, 80},
+		Zones:     []string{"eu", "us"},
+		Keys:      [2]string{"k1", "k2"},
+		Since:     time.Unix(1700000000, 0),
+		Day:       time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		Custom:    tCSV{"p", "q"},
+	}
+
+	values, err := Values(params)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded tParams
+	if err := Decode(values, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(decoded, params) {
+		t.Errorf("unexpected decoded struct:\n%+v\n%+v", decoded, params)
+	}
+}
+
+func TestDecodeMissing(t *testing.T) {
+	decoded := tParams{Name: "keep"}
+	if err := Decode(url.Values{"ratio": {"1.5"}}, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if decoded.Name != "keep" || decoded.Count != nil || decoded.Ratio != 1.5 || decoded.IDs != nil {
+		t.Errorf("unexpected decoded struct: %+v", decoded)
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	var decoded tParams
+	if err := Decode(url.Values{"port": {"ssh"}}, &decoded); err == nil {
+		t.Error("invalid value is decoded")
+	}
+
+	if err := Decode(url.Values{}, decoded); err == nil {
+		t.Error("non-pointer is decoded")
+	}
+}
+
+func TestSetStructParamsError(t *testing.T) {
+	params := url.Values{}
+	err := Apply(&params, Limit(10), SetStructParams("not a struct"))
+	if err == nil {
+		t.Fatal("invalid struct is accepted")
+	}
+
+	if params.Get("limit") != "10" {
+		t.Errorf("valid option is not applied: %v", params)
+	}
+
+	if !strings.Contains(err.Error(), "failed converting struct") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
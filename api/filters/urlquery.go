@@ -135,6 +135,9 @@ func reflectValue(values url.Values, val reflect.Value, scope string) error {
 			handleSliceOrArray(values, name, sv, opts, sf)
 			continue
 		case reflect.Struct:
+			if sv.Type() == timeType {
+				break
+			}
 			// handle recursively nested structs
 			if err := reflectValue(values, sv, name); err != nil {
 				return err
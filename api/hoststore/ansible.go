@@ -0,0 +1,541 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package hoststore
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Prefixes of Ansible groups of exported hosts. Tags which are valid group
+// names are exported with AnsibleTagGroupPrefix, other tags are encoded and
+// exported with AnsibleEncodedTagGroupPrefix.
+const (
+	AnsibleTagGroupPrefix        = "tag_"
+	AnsibleEncodedTagGroupPrefix = "tagx_"
+	AnsibleCloudGroupPrefix      = "cloud_"
+)
+
+// ansibleGroupChars are characters invalid in Ansible group names
+var ansibleGroupChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// tAnsibleGroup is group of Ansible YAML inventory
+type tAnsibleGroup struct {
+	Hosts    map[string]map[string]any `yaml:"hosts,omitempty"`
+	Vars     map[string]any            `yaml:"vars,omitempty"`
+	Children map[string]*tAnsibleGroup `yaml:"children,omitempty"`
+}
+
+/*
+ImportAnsible reads hosts from Ansible YAML inventory. The ansible_host,
+ansible_port and ansible_user variables define address, SSH service and
+principal of host. Groups of host, including parent groups, are imported as
+tags, groups exported by ExportAnsible are imported as tags and cloud
+provider.
+
+Variables follow Ansible precedence: group variables are applied from the
+parent to the child groups, groups of the same depth are ordered by
+ansible_group_priority and name, and host variables override group
+variables.
+
+	all:
+	  children:
+	    webservers:
+	      hosts:
+	        web1.example.com:
+	          ansible_host: 10.0.0.1
+	          ansible_user: deploy
+*/
+func ImportAnsible(r io.Reader) ([]Host, error) {
+	var inventory map[string]*tAnsibleGroup
+	if err := yaml.NewDecoder(r).Decode(&inventory); err != nil && err != io.EOF {
+		return nil, fmt.Errorf("invalid Ansible inventory: %w", err)
+	}
+
+	groups := map[string]*tAnsibleNode{}
+	node := func(name string) *tAnsibleNode {
+		if groups[name] == nil {
+			groups[name] = &tAnsibleNode{
+				name:     name,
+				vars:     map[string]any{},
+				hosts:    map[string]map[string]any{},
+				parents:  map[string]bool{},
+				priority: 1,
+				depth:    -1,
+			}
+		}
+		return groups[name]
+	}
+
+	var collect func(name string, group *tAnsibleGroup, path []string) error
+	collect = func(name string, group *tAnsibleGroup, path []string) error {
+		if contains(path, name) {
+			return fmt.Errorf("invalid Ansible inventory: group %s is its own child", name)
+		}
+
+		n := node(name)
+		if group == nil {
+			return nil
+		}
+
+		for _, key := range sortedKeys(group.Vars) {
+			n.vars[key] = group.Vars[key]
+		}
+		for _, hostname := range sortedKeys(group.Hosts) {
+			if n.hosts[hostname] == nil {
+				n.hosts[hostname] = map[string]any{}
+			}
+			for key, value := range group.Hosts[hostname] {
+				n.hosts[hostname][key] = value
+			}
+		}
+		for _, child := range sortedKeys(group.Children) {
+			node(child).parents[name] = true
+			if err := collect(child, group.Children[child], append(path, name)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, name := range sortedKeys(inventory) {
+		if err := collect(name, inventory[name], nil); err != nil {
+			return nil, err
+		}
+	}
+
+	members := map[string][]*tAnsibleNode{}
+	for _, name := range sortedKeys(groups) {
+		g := groups[name]
+		if name != "all" && len(g.parents) == 0 {
+			g.parents["all"] = true
+		}
+		if priority, ok := g.vars["ansible_group_priority"]; ok {
+			g.priority, _ = strconv.Atoi(fmt.Sprint(priority))
+		}
+		for hostname := range g.hosts {
+			members[hostname] = append(members[hostname], g)
+		}
+	}
+
+	result := make([]Host, 0, len(members))
+	for _, hostname := range sortedKeys(members) {
+		memberOf := ansibleAncestors(groups, members[hostname])
+		sort.Slice(memberOf, func(i, j int) bool {
+			a, b := memberOf[i], memberOf[j]
+			if da, db := a.level(groups), b.level(groups); da != db {
+				return da < db
+			}
+			if a.priority != b.priority {
+				return a.priority < b.priority
+			}
+			return a.name < b.name
+		})
+
+		vars := map[string]any{}
+		for _, g := range memberOf {
+			for key, value := range g.vars {
+				vars[key] = value
+			}
+		}
+		for _, g := range memberOf {
+			for key, value := range g.hosts[hostname] {
+				vars[key] = value
+			}
+		}
+
+		host := &Host{CommonName: hostname}
+		applyAnsibleVars(host, vars)
+		for _, g := range memberOf {
+			applyAnsibleGroup(host, g.name)
+		}
+
+		if len(host.Addresses) == 0 {
+			host.Addresses = []string{hostname}
+		}
+		for i := range host.Services {
+			if host.Services[i].Address == "" {
+				host.Services[i].Address = host.Addresses[0]
+			}
+		}
+		sort.Strings(host.Tags)
+		result = append(result, *host)
+	}
+
+	return result, nil
+}
+
+// tAnsibleNode is Ansible group merged from all definitions of the group
+type tAnsibleNode struct {
+	name     string
+	vars     map[string]any
+	hosts    map[string]map[string]any
+	parents  map[string]bool
+	priority int
+	depth    int
+}
+
+// level returns depth of group from the all group, the longest path counts
+func (n *tAnsibleNode) level(groups map[string]*tAnsibleNode) int {
+	if n.depth >= 0 {
+		return n.depth
+	}
+
+	n.depth = 0
+	for parent := range n.parents {
+		if p, ok := groups[parent]; ok {
+			n.depth = max(n.depth, p.level(groups)+1)
+		} else {
+			n.depth = max(n.depth, 1)
+		}
+	}
+	return n.depth
+}
+
+// ansibleAncestors returns the groups and their parent groups
+func ansibleAncestors(groups map[string]*tAnsibleNode, direct []*tAnsibleNode) []*tAnsibleNode {
+	seen := map[string]bool{}
+	var result []*tAnsibleNode
+
+	var visit func(n *tAnsibleNode)
+	visit = func(n *tAnsibleNode) {
+		if seen[n.name] {
+			return
+		}
+		seen[n.name] = true
+		result = append(result, n)
+		for parent := range n.parents {
+			if p, ok := groups[parent]; ok {
+				visit(p)
+			}
+		}
+	}
+
+	for _, n := range direct {
+		visit(n)
+	}
+	return result
+}
+
+// sortedKeys returns keys of map in order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ExportAnsible writes hosts as Ansible YAML inventory, hosts are grouped
+// by tags and cloud provider. Tags are encoded in group names so that they
+// are restored by ImportAnsible. Hosts sharing a hostname are rejected.
+func ExportAnsible(w io.Writer, hosts []Host) error {
+	all := &tAnsibleGroup{
+		Hosts:    map[string]map[string]any{},
+		Children: map[string]*tAnsibleGroup{},
+	}
+
+	names, err := ansibleHostnames(hosts)
+	if err != nil {
+		return err
+	}
+
+	for i := range hosts {
+		name := names[i]
+		all.Hosts[name] = ansibleVars(&hosts[i])
+
+		for _, group := range ansibleGroups(&hosts[i]) {
+			if all.Children[group] == nil {
+				all.Children[group] = &tAnsibleGroup{Hosts: map[string]map[string]any{}}
+			}
+			all.Children[group].Hosts[name] = nil
+		}
+	}
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(map[string]*tAnsibleGroup{"all": all}); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// ExportAnsibleInventory writes hosts as Ansible dynamic inventory JSON,
+// i.e. output of inventory script for --list. Hosts are grouped by tags
+// and cloud provider, hosts sharing a hostname are rejected.
+func ExportAnsibleInventory(w io.Writer, hosts []Host) error {
+	hostvars := map[string]map[string]any{}
+	groups := map[string][]string{}
+	var ungrouped []string
+
+	names, err := ansibleHostnames(hosts)
+	if err != nil {
+		return err
+	}
+
+	for i := range hosts {
+		name := names[i]
+		hostvars[name] = ansibleVars(&hosts[i])
+
+		memberOf := ansibleGroups(&hosts[i])
+		if len(memberOf) == 0 {
+			ungrouped = append(ungrouped, name)
+		}
+		for _, group := range memberOf {
+			groups[group] = append(groups[group], name)
+		}
+	}
+
+	children := []string{"ungrouped"}
+	inventory := map[string]any{
+		"_meta":     map[string]any{"hostvars": hostvars},
+		"ungrouped": map[string]any{"hosts": nonNil(ungrouped)},
+	}
+	for group, members := range groups {
+		sort.Strings(members)
+		inventory[group] = map[string]any{"hosts": members}
+		children = append(children, group)
+	}
+	sort.Strings(children[1:])
+	inventory["all"] = map[string]any{"children": children}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(inventory)
+}
+
+// applyAnsibleVars sets host fields from Ansible variables
+func applyAnsibleVars(host *Host, vars map[string]any) {
+	if address, ok := vars["ansible_host"]; ok {
+		addr := fmt.Sprint(address)
+		if !contains(host.Addresses, addr) {
+			host.Addresses = append(host.Addresses, addr)
+		}
+	}
+
+	if port, ok := vars["ansible_port"]; ok {
+		p, _ := strconv.Atoi(fmt.Sprint(port))
+		if ssh := findService(host, "SSH"); ssh != nil {
+			ssh.Port = p
+		} else {
+			host.Services = append(host.Services, HostService{Service: "SSH", Port: p})
+		}
+	} else if findService(host, "SSH") == nil {
+		host.Services = append(host.Services, HostService{Service: "SSH", Port: 22})
+	}
+
+	if user, ok := vars["ansible_user"]; ok {
+		principal := fmt.Sprint(user)
+		exists := false
+		for _, p := range host.Principals {
+			exists = exists || p.Principal == principal
+		}
+		if !exists {
+			host.Principals = append(host.Principals, HostPrincipals{Principal: principal})
+		}
+	}
+
+	if id, ok := vars["privx_host_id"]; ok {
+		host.ID = fmt.Sprint(id)
+	}
+	if id, ok := vars["privx_external_id"]; ok {
+		host.ExternalID = fmt.Sprint(id)
+	}
+}
+
+// applyAnsibleGroup sets tags and cloud provider of host from Ansible group
+func applyAnsibleGroup(host *Host, group string) {
+	switch {
+	case group == "all" || group == "ungrouped":
+	case strings.HasPrefix(group, AnsibleCloudGroupPrefix):
+		host.CloudProvider = strings.ToUpper(strings.TrimPrefix(group, AnsibleCloudGroupPrefix))
+	default:
+		tag := group
+		if name, ok := strings.CutPrefix(group, AnsibleEncodedTagGroupPrefix); ok {
+			if decoded, ok := decodeAnsibleGroup(name); ok {
+				tag = decoded
+			}
+		} else if name, ok := strings.CutPrefix(group, AnsibleTagGroupPrefix); ok && name != "" {
+			tag = name
+		}
+		if !contains(host.Tags, tag) {
+			host.Tags = append(host.Tags, tag)
+		}
+	}
+}
+
+// ansibleHostnames names hosts in Ansible inventory, hosts sharing a name
+// are rejected
+func ansibleHostnames(hosts []Host) ([]string, error) {
+	names := make([]string, len(hosts))
+	seen := map[string]string{}
+	for i := range hosts {
+		name := ansibleHostname(&hosts[i])
+		if id, exists := seen[name]; exists {
+			return nil, fmt.Errorf("hosts %s and %s share Ansible hostname %s", id, hosts[i].ID, name)
+		}
+		seen[name] = hosts[i].ID
+		names[i] = name
+	}
+	return names, nil
+}
+
+// ansibleHostname names host in Ansible inventory
+func ansibleHostname(host *Host) string {
+	switch {
+	case host.CommonName != "":
+		return host.CommonName
+	case len(host.Addresses) > 0:
+		return host.Addresses[0]
+	default:
+		return host.ID
+	}
+}
+
+// ansibleVars returns Ansible variables of host
+func ansibleVars(host *Host) map[string]any {
+	vars := map[string]any{}
+
+	if len(host.Addresses) > 0 {
+		vars["ansible_host"] = host.Addresses[0]
+	}
+	if ssh := findService(host, "SSH"); ssh != nil && ssh.Port != 0 && ssh.Port != 22 {
+		vars["ansible_port"] = ssh.Port
+	}
+	if len(host.Principals) > 0 {
+		vars["ansible_user"] = host.Principals[0].Principal
+	}
+	if host.ID != "" {
+		vars["privx_host_id"] = host.ID
+	}
+	if host.ExternalID != "" {
+		vars["privx_external_id"] = host.ExternalID
+	}
+
+	return vars
+}
+
+// ansibleGroups returns Ansible groups of host
+func ansibleGroups(host *Host) []string {
+	var groups []string
+
+	for _, tag := range host.Tags {
+		switch {
+		case tag == "":
+		case ansibleGroupChars.MatchString(tag):
+			groups = append(groups, AnsibleEncodedTagGroupPrefix+encodeAnsibleGroup(tag))
+		default:
+			groups = append(groups, AnsibleTagGroupPrefix+tag)
+		}
+	}
+	if group := ansibleGroupName(host.CloudProvider); group != "" {
+		groups = append(groups, AnsibleCloudGroupPrefix+group)
+	}
+
+	return groups
+}
+
+// ansibleGroupName sanitizes name for Ansible group
+func ansibleGroupName(name string) string {
+	return strings.Trim(ansibleGroupChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+}
+
+// encodeAnsibleGroup encodes tag as valid Ansible group name. Lower case
+// letters and digits are kept, underscore is doubled and other bytes are
+// escaped as underscore and two hex digits, e.g. "Web-1" is "_57eb_2d1".
+func encodeAnsibleGroup(tag string) string {
+	var b strings.Builder
+	for i := 0; i < len(tag); i++ {
+		switch c := tag[i]; {
+		case c >= 'a' && c <= 'z', c >= '0' && c <= '9':
+			b.WriteByte(c)
+		case c == '_':
+			b.WriteString("__")
+		default:
+			fmt.Fprintf(&b, "_%02x", c)
+		}
+	}
+	return b.String()
+}
+
+// decodeAnsibleGroup decodes tag encoded by encodeAnsibleGroup, it fails
+// for names which encodeAnsibleGroup does not produce
+func decodeAnsibleGroup(name string) (string, bool) {
+	var b strings.Builder
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c >= 'a' && c <= 'z', c >= '0' && c <= '9':
+			b.WriteByte(c)
+			continue
+		case c != '_':
+			return "", false
+		}
+
+		if i+1 < len(name) && name[i+1] == '_' {
+			b.WriteByte('_')
+			i++
+			continue
+		}
+		if i+2 >= len(name) {
+			return "", false
+		}
+		hex := name[i+1 : i+3]
+		if strings.ToLower(hex) != hex {
+			return "", false
+		}
+		v, err := strconv.ParseUint(hex, 16, 8)
+		if err != nil || !ansibleGroupChars.MatchString(string(rune(v))) {
+			return "", false
+		}
+		b.WriteByte(byte(v))
+		i += 2
+	}
+
+	tag := b.String()
+	if !utf8.ValidString(tag) {
+		return "", false
+	}
+	return tag, true
+}
+
+// findService returns service of host by type
+func findService(host *Host, service string) *HostService {
+	for i := range host.Services {
+		if strings.EqualFold(host.Services[i].Service, service) {
+			return &host.Services[i]
+		}
+	}
+	return nil
+}
+
+// contains checks if list contains the value
+func contains(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// nonNil returns empty list instead of nil
+func nonNil(list []string) []string {
+	if list == nil {
+		return []string{}
+	}
+	return list
+}
@@ -6,6 +6,8 @@
 
 package hoststore
 
+//go:generate go run github.com/SSHcom/privx-sdk-go/v2/internal/mockgen
+
 import (
 	"net/url"
 
@@ -42,8 +44,8 @@ func (c *HostStore) SearchHosts(search *HostSearch, opts ...filters.Option) (*re
 	hosts := &response.ResultSet[Host]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -59,8 +61,8 @@ func (c *HostStore) GetHosts(opts ...filters.Option) (*response.ResultSet[Host],
 	hosts := &response.ResultSet[Host]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -73,6 +75,10 @@ func (c *HostStore) GetHosts(opts ...filters.Option) (*response.ResultSet[Host],
 
 // CreateHost create a host.
 func (c *HostStore) CreateHost(host *Host) (response.Identifier, error) {
+	if err := host.Validate(); err != nil {
+		return response.Identifier{}, err
+	}
+
 	identifier := response.Identifier{}
 
 	_, err := c.api.
@@ -106,6 +112,10 @@ func (c *HostStore) GetHost(hostID string) (*Host, error) {
 
 // UpdateHost update host.
 func (c *HostStore) UpdateHost(hostID string, host *Host) error {
+	if err := host.Validate(); err != nil {
+		return err
+	}
+
 	_, err := c.api.
 		URL("/host-store/api/v1/hosts/%s", hostID).
 		Put(&host)
@@ -124,6 +134,10 @@ func (c *HostStore) DeleteHost(hostID string) error {
 
 // DeployHost deploy host.
 func (c *HostStore) DeployHost(host *Host) (HostResponse, error) {
+	if err := host.Validate(); err != nil {
+		return HostResponse{}, err
+	}
+
 	response := HostResponse{}
 
 	_, err := c.api.
@@ -151,8 +165,8 @@ func (c *HostStore) GetHostTags(opts ...filters.Option) (*response.ResultSet[str
 	tags := &response.ResultSet[string]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -194,8 +208,8 @@ func (c *HostStore) GetWhitelists(opts ...filters.Option) (*response.ResultSet[W
 	result := &response.ResultSet[Whitelist]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 	_, err := c.api.
 		URL("/host-store/api/v1/whitelists").
@@ -248,8 +262,8 @@ func (c *HostStore) SearchWhitelists(search WhitelistSearch, opts ...filters.Opt
 	whitelists := &response.ResultSet[Whitelist]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -276,8 +290,8 @@ func (c *HostStore) GetSessionHostCertificates(hostID string, opts ...filters.Op
 	certs := &response.ResultSet[SessionHostCertificateResponse]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -297,7 +311,7 @@ func (c *HostStore) DeleteSessionHostCertificates(hostID string) error {
 	return err
 }
 
-// DeleteSessionHostCertificates delete session host certificate by host id.
+// DeleteSessionHostCertificate delete session host certificate by host id.
 func (c *HostStore) DeleteSessionHostCertificate(hostID, certID string) error {
 	_, err := c.api.
 		URL("/host-store/api/v1/hosts/%s/session_host_certificates/%s", hostID, certID).
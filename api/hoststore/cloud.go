@@ -0,0 +1,419 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package hoststore
+
+import (
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/SSHcom/privx-sdk-go/v2/api/rolestore"
+)
+
+// Canonical names of cloud providers.
+const (
+	CloudAWS       = "AWS"
+	CloudAzure     = "AZURE"
+	CloudGCP       = "GCP"
+	CloudOpenStack = "OPENSTACK"
+)
+
+// Reasons of duplicate cloud hosts.
+const (
+	DuplicateInstanceID = "instance_id"
+	DuplicateAddress    = "address"
+)
+
+// cloudProviders maps provider aliases to canonical names
+var cloudProviders = map[string]string{
+	"aws":         CloudAWS,
+	"amazon":      CloudAWS,
+	"ec2":         CloudAWS,
+	"azure":       CloudAzure,
+	"microsoft":   CloudAzure,
+	"gcp":         CloudGCP,
+	"gce":         CloudGCP,
+	"google":      CloudGCP,
+	"googlecloud": CloudGCP,
+	"openstack":   CloudOpenStack,
+}
+
+var (
+	// awsZone is AWS availability zone, e.g. eu-west-1a
+	awsZone = regexp.MustCompile(`^([a-z]{2}(-gov)?-[a-z]+-\d+)[a-z]$`)
+	// gcpZone is GCP zone, e.g. europe-west1-b
+	gcpZone = regexp.MustCompile(`^([a-z]+-[a-z]+\d+)-[a-z]$`)
+	// awsARN is ARN of AWS EC2 instance
+	awsARN = regexp.MustCompile(`^arn:aws[a-z-]*:ec2:([^:]*):(\d*):instance/(.+)$`)
+	// awsAccount is account of any AWS ARN
+	awsAccount = regexp.MustCompile(`^arn:aws[a-z-]*:[^:]*:[^:]*:(\d+):`)
+	// azureResource is id of Azure virtual machine resource
+	azureResource = regexp.MustCompile(`(?i)^/subscriptions/([^/]+)/resourceGroups/[^/]+/providers/Microsoft\.Compute/virtualMachines/([^/]+)$`)
+	// gcpResource is self link or name of GCP instance
+	gcpResource = regexp.MustCompile(`projects/([^/]+)/zones/([^/]+)/instances/([^/]+)$`)
+)
+
+// CloudMetadata normalised cloud metadata of host.
+type CloudMetadata struct {
+	Provider   string `json:"provider,omitempty"`
+	Region     string `json:"region,omitempty"`
+	Account    string `json:"account,omitempty"`
+	InstanceID string `json:"instance_id,omitempty"`
+}
+
+/*
+CloudMetadataOf derives normalised cloud metadata of host. Provider names
+are canonical, regions are lower case and availability zones are reduced
+to regions. Region, account and instance id are completed from AWS ARN,
+Azure resource id or GCP instance link in external id or instance id.
+*/
+func CloudMetadataOf(host *Host) CloudMetadata {
+	m := CloudMetadata{
+		Provider:   NormalizeCloudProvider(host.CloudProvider),
+		Region:     normalizeRegion(host.CloudProviderRegion),
+		InstanceID: strings.TrimSpace(host.InstanceID),
+	}
+
+	for _, id := range []string{host.InstanceID, host.ExternalID} {
+		id = strings.TrimSpace(id)
+
+		if match := awsARN.FindStringSubmatch(id); match != nil {
+			m.set(CloudAWS, match[1], match[2], match[3])
+		} else if match := azureResource.FindStringSubmatch(id); match != nil {
+			m.set(CloudAzure, "", match[1], match[2])
+		} else if match := gcpResource.FindStringSubmatch(id); match != nil {
+			m.set(CloudGCP, normalizeRegion(match[2]), match[1], match[3])
+		}
+	}
+
+	if m.Provider == "" && strings.HasPrefix(m.InstanceID, "i-") {
+		m.Provider = CloudAWS
+	}
+	m.Region = normalizeRegion(m.Region)
+
+	return m
+}
+
+// set completes missing metadata, instance id parsed from resource id
+// replaces the resource id
+func (m *CloudMetadata) set(provider, region, account, instanceID string) {
+	if m.Provider == "" {
+		m.Provider = provider
+	}
+	if m.Region == "" {
+		m.Region = region
+	}
+	if m.Account == "" {
+		m.Account = account
+	}
+	if m.InstanceID == "" || strings.Contains(m.InstanceID, "/") {
+		m.InstanceID = instanceID
+	}
+}
+
+// NormalizeCloudProvider returns canonical name of cloud provider.
+func NormalizeCloudProvider(provider string) string {
+	key := strings.ToLower(strings.NewReplacer(" ", "", "-", "", "_", "").Replace(provider))
+	if canonical, ok := cloudProviders[key]; ok {
+		return canonical
+	}
+	return strings.ToUpper(strings.TrimSpace(provider))
+}
+
+// NormalizeCloudMetadata writes normalised provider and region to host. It
+// returns true if the host was changed, the host can be updated with
+// UpdateHost. Instance id is owned by the source sync and it is left as is,
+// the derived instance id is available from CloudMetadataOf.
+func NormalizeCloudMetadata(host *Host) bool {
+	m := CloudMetadataOf(host)
+	changed := false
+
+	for _, f := range []struct {
+		field *string
+		value string
+	}{
+		{&host.CloudProvider, m.Provider},
+		{&host.CloudProviderRegion, m.Region},
+	} {
+		if *f.field != f.value {
+			*f.field = f.value
+			changed = true
+		}
+	}
+
+	return changed
+}
+
+// normalizeRegion lower cases region and reduces availability zone to region
+func normalizeRegion(region string) string {
+	region = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(region), " ", ""))
+	if match := awsZone.FindStringSubmatch(region); match != nil {
+		return match[1]
+	}
+	if match := gcpZone.FindStringSubmatch(region); match != nil {
+		return match[1]
+	}
+	return region
+}
+
+// CloudHost host with normalised cloud metadata.
+type CloudHost struct {
+	CloudMetadata
+	Host *Host `json:"host"`
+}
+
+// CloudGroup hosts of cloud provider, region and account.
+type CloudGroup struct {
+	CloudMetadata
+	Hosts []CloudHost `json:"hosts"`
+}
+
+// Duplicate hosts sharing instance id or address across sources. Provider
+// and account scope the value, they are empty for public addresses.
+type Duplicate struct {
+	Reason   string      `json:"reason"`
+	Value    string      `json:"value"`
+	Provider string      `json:"provider,omitempty"`
+	Account  string      `json:"account,omitempty"`
+	Hosts    []CloudHost `json:"hosts"`
+}
+
+/*
+CloudInventory queries hosts by normalised cloud metadata. Accounts missing
+from host metadata are completed from connection of host source, i.e.
+Azure subscription, single GCP project or account of AWS IAM role.
+
+	inventory, err := hoststore.LoadCloudInventory(hoststore.New(auth), rolestore.New(auth), nil)
+	if err != nil {
+		return err
+	}
+
+	for _, host := range inventory.Orphans() {
+		fmt.Println(host.Host.ID, host.Host.SourceID)
+	}
+*/
+type CloudInventory struct {
+	Hosts   []CloudHost
+	sources map[string]*rolestore.Source
+}
+
+// NewCloudInventory creates cloud inventory of hosts and role store sources.
+func NewCloudInventory(hosts []Host, sources []rolestore.Source) *CloudInventory {
+	inventory := &CloudInventory{
+		Hosts:   make([]CloudHost, 0, len(hosts)),
+		sources: map[string]*rolestore.Source{},
+	}
+
+	for i := range sources {
+		inventory.sources[sources[i].ID] = &sources[i]
+	}
+
+	for i := range hosts {
+		m := CloudMetadataOf(&hosts[i])
+		if source, ok := inventory.sources[hosts[i].SourceID]; ok && m.Account == "" {
+			m.Account = sourceAccount(source)
+		}
+		inventory.Hosts = append(inventory.Hosts, CloudHost{CloudMetadata: m, Host: &hosts[i]})
+	}
+
+	return inventory
+}
+
+// LoadCloudInventory fetches hosts matching the search and role store
+// sources, nil search matches all hosts.
+func LoadCloudInventory(store Service, roles rolestore.Service, search *HostSearch) (*CloudInventory, error) {
+	hosts, err := AllHosts(store, search)
+	if err != nil {
+		return nil, err
+	}
+
+	sources, err := roles.GetSources()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCloudInventory(hosts, sources.Items), nil
+}
+
+// Query returns hosts of cloud provider, region and account, empty values
+// match any.
+func (i *CloudInventory) Query(provider, region, account string) []CloudHost {
+	provider = NormalizeCloudProvider(provider)
+	region = normalizeRegion(region)
+
+	var hosts []CloudHost
+	for _, h := range i.Hosts {
+		if (provider == "" || h.Provider == provider) &&
+			(region == "" || h.Region == region) &&
+			(account == "" || h.Account == account) {
+			hosts = append(hosts, h)
+		}
+	}
+	return hosts
+}
+
+// Groups groups hosts by cloud provider, region and account.
+func (i *CloudInventory) Groups() []CloudGroup {
+	index := map[CloudMetadata]int{}
+	var groups []CloudGroup
+
+	for _, h := range i.Hosts {
+		key := CloudMetadata{Provider: h.Provider, Region: h.Region, Account: h.Account}
+		n, ok := index[key]
+		if !ok {
+			n = len(groups)
+			index[key] = n
+			groups = append(groups, CloudGroup{CloudMetadata: key})
+		}
+		groups[n].Hosts = append(groups[n].Hosts, h)
+	}
+
+	sort.Slice(groups, func(a, b int) bool {
+		x, y := groups[a], groups[b]
+		if x.Provider != y.Provider {
+			return x.Provider < y.Provider
+		}
+		if x.Region != y.Region {
+			return x.Region < y.Region
+		}
+		return x.Account < y.Account
+	})
+
+	return groups
+}
+
+// Orphans returns hosts whose source no longer exists in role store.
+func (i *CloudInventory) Orphans() []CloudHost {
+	var orphans []CloudHost
+	for _, h := range i.Hosts {
+		if h.Host.SourceID == "" {
+			continue
+		}
+		if _, ok := i.sources[h.Host.SourceID]; !ok {
+			orphans = append(orphans, h)
+		}
+	}
+	return orphans
+}
+
+/*
+Duplicates returns hosts of different sources sharing instance id or
+address. Instance ids are unique within provider and account only, e.g.
+GCP and Azure instance ids are VM names, so duplicates are searched per
+provider and account. Private addresses overlap across networks, they are
+searched per provider and account as well, public addresses across all
+hosts.
+*/
+func (i *CloudInventory) Duplicates() []Duplicate {
+	byInstance := map[tDuplicateKey][]CloudHost{}
+	byAddress := map[tDuplicateKey][]CloudHost{}
+
+	for _, h := range i.Hosts {
+		if h.InstanceID != "" {
+			key := tDuplicateKey{value: strings.ToLower(h.InstanceID), provider: h.Provider, account: h.Account}
+			byInstance[key] = append(byInstance[key], h)
+		}
+
+		var seen []string
+		for _, address := range h.Host.Addresses {
+			value := strings.ToLower(strings.TrimSpace(address))
+			if value == "" || contains(seen, value) {
+				continue
+			}
+			seen = append(seen, value)
+
+			key := tDuplicateKey{value: value}
+			if isPrivateAddress(value) {
+				key.provider, key.account = h.Provider, h.Account
+			}
+			byAddress[key] = append(byAddress[key], h)
+		}
+	}
+
+	var duplicates []Duplicate
+	for _, index := range []struct {
+		reason string
+		hosts  map[tDuplicateKey][]CloudHost
+	}{
+		{DuplicateInstanceID, byInstance},
+		{DuplicateAddress, byAddress},
+	} {
+		keys := make([]tDuplicateKey, 0, len(index.hosts))
+		for key, hosts := range index.hosts {
+			if acrossSources(hosts) {
+				keys = append(keys, key)
+			}
+		}
+		sort.Slice(keys, func(a, b int) bool {
+			x, y := keys[a], keys[b]
+			if x.value != y.value {
+				return x.value < y.value
+			}
+			if x.provider != y.provider {
+				return x.provider < y.provider
+			}
+			return x.account < y.account
+		})
+
+		for _, key := range keys {
+			duplicates = append(duplicates, Duplicate{
+				Reason:   index.reason,
+				Value:    key.value,
+				Provider: key.provider,
+				Account:  key.account,
+				Hosts:    index.hosts[key],
+			})
+		}
+	}
+
+	return duplicates
+}
+
+// tDuplicateKey is value of duplicate hosts scoped by provider and account
+type tDuplicateKey struct {
+	value    string
+	provider string
+	account  string
+}
+
+// isPrivateAddress checks if address is private, loopback or link local
+// ip address, host names are not private
+func isPrivateAddress(address string) bool {
+	ip := net.ParseIP(address)
+	return ip != nil && (ip.IsPrivate() || ip.IsLoopback() || ip.IsLinkLocalUnicast())
+}
+
+// acrossSources checks if hosts come from more than one source
+func acrossSources(hosts []CloudHost) bool {
+	for _, h := range hosts[1:] {
+		if h.Host.SourceID != hosts[0].Host.SourceID {
+			return true
+		}
+	}
+	return false
+}
+
+// sourceAccount returns cloud account of role store source
+func sourceAccount(source *rolestore.Source) string {
+	conn := &source.Connection
+
+	switch NormalizeCloudProvider(conn.Type) {
+	case CloudAzure:
+		return conn.AzureSubscriptionID
+	case CloudGCP:
+		if len(conn.GoogleCloudProjectIDs) == 1 {
+			return conn.GoogleCloudProjectIDs[0]
+		}
+	case CloudAWS:
+		if match := awsAccount.FindStringSubmatch(conn.IAMRoleARN); match != nil {
+			return match[1]
+		}
+	}
+
+	return ""
+}
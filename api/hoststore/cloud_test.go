@@ -0,0 +1,103 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package hoststore
+
+import (
+	"testing"
+
+	"github.com/SSHcom/privx-sdk-go/v2/api/rolestore"
+)
+
+func TestCloudMetadataOf(t *testing.T) {
+	for _, tc := range []struct {
+		host   Host
+		expect CloudMetadata
+	}{
+		{
+			Host{CloudProvider: "amazon", CloudProviderRegion: "EU-WEST-1a", InstanceID: "i-0123"},
+			CloudMetadata{Provider: CloudAWS, Region: "eu-west-1", InstanceID: "i-0123"},
+		},
+		{
+			Host{ExternalID: "arn:aws:ec2:us-east-1:123456789012:instance/i-0456"},
+			CloudMetadata{Provider: CloudAWS, Region: "us-east-1", Account: "123456789012", InstanceID: "i-0456"},
+		},
+		{
+			Host{CloudProvider: "Azure", CloudProviderRegion: "West Europe", ExternalID: "/subscriptions/sub-1/resourceGroups/rg/providers/Microsoft.Compute/virtualMachines/vm1"},
+			CloudMetadata{Provider: CloudAzure, Region: "westeurope", Account: "sub-1", InstanceID: "vm1"},
+		},
+		{
+			Host{CloudProvider: "google", InstanceID: "https://www.googleapis.com/compute/v1/projects/proj/zones/europe-west1-b/instances/web"},
+			CloudMetadata{Provider: CloudGCP, Region: "europe-west1", Account: "proj", InstanceID: "web"},
+		},
+		{
+			Host{CommonName: "on-prem"},
+			CloudMetadata{},
+		},
+	} {
+		if m := CloudMetadataOf(&tc.host); m != tc.expect {
+			t.Errorf("%+v: got %+v, expected %+v", tc.host, m, tc.expect)
+		}
+	}
+
+	host := Host{CloudProvider: "ec2", CloudProviderRegion: "eu-north-1b", InstanceID: "arn:aws:ec2:eu-north-1:1:instance/i-1"}
+	if !NormalizeCloudMetadata(&host) || host.CloudProvider != CloudAWS || host.CloudProviderRegion != "eu-north-1" ||
+		host.InstanceID != "arn:aws:ec2:eu-north-1:1:instance/i-1" {
+		t.Errorf("host not normalised %+v", host)
+	}
+	if NormalizeCloudMetadata(&host) {
+		t.Error("normalised host changed")
+	}
+}
+
+func TestCloudInventory(t *testing.T) {
+	hosts := []Host{
+		{ID: "1", SourceID: "aws", CloudProvider: "AWS", CloudProviderRegion: "eu-west-1", InstanceID: "i-1", Addresses: []string{"10.0.0.1"}},
+		{ID: "2", SourceID: "aws", CloudProvider: "AWS", CloudProviderRegion: "eu-west-1a", InstanceID: "i-2", Addresses: []string{"203.0.113.5"}},
+		{ID: "3", SourceID: "azure", CloudProvider: "azure", CloudProviderRegion: "westeurope", InstanceID: "vm1", Addresses: []string{"203.0.113.5", "10.1.0.1"}},
+		{ID: "4", SourceID: "gone", CloudProvider: "AWS", CloudProviderRegion: "eu-west-1", InstanceID: "I-1",
+			ExternalID: "arn:aws:ec2:eu-west-1:111122223333:instance/i-1", Addresses: []string{"10.0.0.1"}},
+		{ID: "5", Addresses: []string{"10.0.0.1"}},
+		{ID: "6", SourceID: "azure2", CloudProvider: "azure", CloudProviderRegion: "westeurope", InstanceID: "vm1", Addresses: []string{"10.1.0.1"}},
+	}
+	sources := []rolestore.Source{
+		{ID: "aws", Connection: rolestore.SourceConnection{Type: "AWS", IAMRoleARN: "arn:aws:iam::111122223333:role/privx"}},
+		{ID: "azure", Connection: rolestore.SourceConnection{Type: "AZURE", AzureSubscriptionID: "sub-1"}},
+		{ID: "azure2", Connection: rolestore.SourceConnection{Type: "AZURE", AzureSubscriptionID: "sub-2"}},
+	}
+
+	inventory := NewCloudInventory(hosts, sources)
+
+	if found := inventory.Query("aws", "", "111122223333"); len(found) != 3 {
+		t.Errorf("unexpected query result %+v", found)
+	}
+
+	groups := inventory.Groups()
+	if len(groups) != 4 || groups[1].Provider != CloudAWS || groups[1].Account != "111122223333" || len(groups[1].Hosts) != 3 {
+		t.Errorf("unexpected groups %+v", groups)
+	}
+
+	if orphans := inventory.Orphans(); len(orphans) != 1 || orphans[0].Host.ID != "4" {
+		t.Errorf("unexpected orphans %+v", orphans)
+	}
+
+	// same VM name and private address in different subscriptions and
+	// private address outside cloud are not duplicates
+	duplicates := inventory.Duplicates()
+	if len(duplicates) != 3 {
+		t.Fatalf("unexpected duplicates %+v", duplicates)
+	}
+	for i, expect := range []struct{ reason, value, account string }{
+		{DuplicateInstanceID, "i-1", "111122223333"},
+		{DuplicateAddress, "10.0.0.1", "111122223333"},
+		{DuplicateAddress, "203.0.113.5", ""},
+	} {
+		d := duplicates[i]
+		if d.Reason != expect.reason || d.Value != expect.value || d.Account != expect.account || len(d.Hosts) != 2 {
+			t.Errorf("unexpected duplicate %+v", d)
+		}
+	}
+}
@@ -0,0 +1,243 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package hoststore
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Requirements of session recording policy rules.
+const (
+	RequireAudit                 Requirement = "audit"
+	RequireFileTransferRecording Requirement = "file_transfer_recording"
+	RequireClipboardRecording    Requirement = "clipboard_recording"
+)
+
+// Requirement definition for requirements of session recording policy rules.
+type Requirement string
+
+// PolicyRule applies requirements to hosts matching the rule. Host matches
+// if it has any of the tags and any of the classifications, empty lists
+// match all hosts.
+type PolicyRule struct {
+	Name            string        `json:"name"`
+	Tags            []string      `json:"tags,omitempty"`
+	Classifications []string      `json:"classifications,omitempty"`
+	Require         []Requirement `json:"require"`
+}
+
+// matches checks if host matches the rule
+func (r *PolicyRule) matches(host *Host) bool {
+	return matchesAny(r.Tags, host.Tags...) &&
+		matchesAny(r.Classifications, host.HostClassification)
+}
+
+/*
+RecordingPolicy defines session recording requirements of hosts.
+
+	policy := &hoststore.RecordingPolicy{
+		Rules: []hoststore.PolicyRule{
+			{
+				Name:    "production",
+				Tags:    []string{"prod"},
+				Require: []hoststore.Requirement{hoststore.RequireAudit, hoststore.RequireFileTransferRecording},
+			},
+		},
+	}
+*/
+type RecordingPolicy struct {
+	Rules []PolicyRule `json:"rules"`
+}
+
+// Violation requirement of policy rule the host does not meet.
+type Violation struct {
+	HostID      string      `json:"host_id"`
+	Host        string      `json:"host"`
+	Rule        string      `json:"rule"`
+	Requirement Requirement `json:"requirement"`
+}
+
+// Remediation fixes violations of host. It holds the requirements to meet
+// rather than snapshot of the host, the changes are applied to the host
+// current at the time of remediation.
+type Remediation struct {
+	HostID     string        `json:"host_id"`
+	Require    []Requirement `json:"require"`
+	Violations []Violation   `json:"violations"`
+}
+
+// Apply changes audit and session recording options of host to meet the
+// requirements. It returns true if the host was changed.
+func (r *Remediation) Apply(host *Host) bool {
+	changed := false
+	for _, requirement := range r.Require {
+		if !meetsRequirement(host, requirement) {
+			fixRequirement(host, requirement)
+			changed = true
+		}
+	}
+	return changed
+}
+
+// ComplianceReport result of session recording policy check.
+type ComplianceReport struct {
+	Hosts        int           `json:"hosts"`
+	Compliant    int           `json:"compliant"`
+	Violations   []Violation   `json:"violations"`
+	Remediations []Remediation `json:"remediations"`
+}
+
+// Evaluate checks host against the policy. It returns violations of the
+// host, requirement of several rules is reported once by the first rule.
+func (p *RecordingPolicy) Evaluate(host *Host) []Violation {
+	var violations []Violation
+	seen := map[Requirement]bool{}
+
+	for _, rule := range p.Rules {
+		if !rule.matches(host) {
+			continue
+		}
+
+		for _, requirement := range rule.Require {
+			if seen[requirement] || meetsRequirement(host, requirement) {
+				continue
+			}
+			seen[requirement] = true
+
+			violations = append(violations, Violation{
+				HostID:      host.ID,
+				Host:        host.CommonName,
+				Rule:        rule.Name,
+				Requirement: requirement,
+			})
+		}
+	}
+
+	return violations
+}
+
+// Validate checks that the policy requirements are known.
+func (p *RecordingPolicy) Validate() error {
+	for _, rule := range p.Rules {
+		for _, requirement := range rule.Require {
+			switch requirement {
+			case RequireAudit, RequireFileTransferRecording, RequireClipboardRecording:
+			default:
+				return fmt.Errorf("rule %s: unknown requirement %q", rule.Name, requirement)
+			}
+		}
+	}
+	return nil
+}
+
+// CheckCompliance evaluates all hosts matching the search against the
+// policy, nil search matches all hosts.
+func CheckCompliance(store Service, policy *RecordingPolicy, search *HostSearch) (*ComplianceReport, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	hosts, err := AllHosts(store, search)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ComplianceReport{
+		Hosts:        len(hosts),
+		Violations:   []Violation{},
+		Remediations: []Remediation{},
+	}
+
+	for i := range hosts {
+		violations := policy.Evaluate(&hosts[i])
+		if len(violations) == 0 {
+			report.Compliant++
+			continue
+		}
+
+		require := make([]Requirement, len(violations))
+		for j, violation := range violations {
+			require[j] = violation.Requirement
+		}
+
+		report.Violations = append(report.Violations, violations...)
+		report.Remediations = append(report.Remediations, Remediation{
+			HostID:     hosts[i].ID,
+			Require:    require,
+			Violations: violations,
+		})
+	}
+
+	return report, nil
+}
+
+// Remediate fetches non-compliant hosts and updates them with the changes
+// of remediations, hosts compliant by now are not updated. Failed updates do
+// not stop the remaining ones.
+func (r *ComplianceReport) Remediate(store Service) error {
+	var errs []error
+	for _, remediation := range r.Remediations {
+		host, err := store.GetHost(remediation.HostID)
+		if err == nil && remediation.Apply(host) {
+			err = store.UpdateHost(remediation.HostID, host)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to remediate host %s: %w", remediation.HostID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// meetsRequirement checks if host meets the requirement
+func meetsRequirement(host *Host, requirement Requirement) bool {
+	options := host.SessionRecordingOptions
+
+	switch requirement {
+	case RequireAudit:
+		return host.AuditEnabled != nil && *host.AuditEnabled
+	case RequireFileTransferRecording:
+		return options == nil || !options.DisableFileTransferRecording
+	case RequireClipboardRecording:
+		return options == nil || !options.DisableClipboardRecording
+	}
+	return true
+}
+
+// fixRequirement changes host to meet the requirement
+func fixRequirement(host *Host, requirement Requirement) {
+	switch requirement {
+	case RequireAudit:
+		enabled := true
+		host.AuditEnabled = &enabled
+	case RequireFileTransferRecording, RequireClipboardRecording:
+		options := SessionRecordingOptions{}
+		if host.SessionRecordingOptions != nil {
+			options = *host.SessionRecordingOptions
+		}
+		if requirement == RequireFileTransferRecording {
+			options.DisableFileTransferRecording = false
+		} else {
+			options.DisableClipboardRecording = false
+		}
+		host.SessionRecordingOptions = &options
+	}
+}
+
+// matchesAny checks if any of the values is in the list, empty list
+// matches any values
+func matchesAny(list []string, values ...string) bool {
+	if len(list) == 0 {
+		return true
+	}
+	for _, value := range values {
+		if containsFold(list, value) {
+			return true
+		}
+	}
+	return false
+}
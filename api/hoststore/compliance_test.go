@@ -0,0 +1,72 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package hoststore
+
+import (
+	"testing"
+)
+
+func TestCheckCompliance(t *testing.T) {
+	enabled, disabled := true, false
+	hosts := []Host{
+		{ID: "1", CommonName: "web", Tags: []string{"prod"}, AuditEnabled: &enabled},
+		{ID: "2", CommonName: "db", Tags: []string{"PROD"}, AuditEnabled: &disabled,
+			SessionRecordingOptions: &SessionRecordingOptions{DisableFileTransferRecording: true, DisableClipboardRecording: true}},
+		{ID: "3", CommonName: "dev", Tags: []string{"dev"}},
+		{ID: "4", CommonName: "jump", Tags: []string{"prod"}, HostClassification: "critical"},
+	}
+
+	policy := &RecordingPolicy{
+		Rules: []PolicyRule{
+			{Name: "production", Tags: []string{"prod"}, Require: []Requirement{RequireAudit, RequireFileTransferRecording}},
+			{Name: "critical", Classifications: []string{"critical"}, Require: []Requirement{RequireAudit}},
+		},
+	}
+
+	var changes []string
+	store := fakeStore(hosts, &changes)
+
+	report, err := CheckCompliance(store, policy, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if report.Hosts != 4 || report.Compliant != 2 || len(report.Violations) != 3 || len(report.Remediations) != 2 {
+		t.Fatalf("unexpected report %+v", report)
+	}
+
+	// audit required by both rules is reported once
+	if r := report.Remediations[1]; r.HostID != "4" || len(r.Violations) != 1 || r.Violations[0].Rule != "production" {
+		t.Errorf("unexpected remediation %+v", r)
+	}
+
+	fixed := hosts[1]
+	if !report.Remediations[0].Apply(&fixed) || !*fixed.AuditEnabled || fixed.SessionRecordingOptions.DisableFileTransferRecording {
+		t.Errorf("host not remediated %+v", fixed)
+	}
+	if !fixed.SessionRecordingOptions.DisableClipboardRecording {
+		t.Error("remediation changed options not required by policy")
+	}
+	if *hosts[1].AuditEnabled || !hosts[1].SessionRecordingOptions.DisableFileTransferRecording {
+		t.Error("remediation changed the current host")
+	}
+
+	// changes made after the check are preserved, compliant hosts are skipped
+	hosts[1].Comment = "changed"
+	hosts[3].AuditEnabled = &enabled
+	if err := report.Remediate(store); err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0] != "update 2 changed" {
+		t.Errorf("unexpected changes %v", changes)
+	}
+
+	invalid := &RecordingPolicy{Rules: []PolicyRule{{Name: "x", Require: []Requirement{"unknown"}}}}
+	if _, err := CheckCompliance(store, invalid, nil); err == nil {
+		t.Error("unknown requirement accepted")
+	}
+}
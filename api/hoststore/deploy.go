@@ -0,0 +1,368 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package hoststore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Steps of host deployment.
+const (
+	StepDeploy       = "deploy"
+	StepPrincipals   = "principals"
+	StepDeployStatus = "deploy_status"
+	StepWait         = "wait"
+)
+
+// Statuses of host deployment steps.
+const (
+	StepDone    StepStatus = "done"
+	StepSkipped StepStatus = "skipped"
+	StepFailed  StepStatus = "failed"
+)
+
+// StepStatus definition for statuses of host deployment steps.
+type StepStatus string
+
+// Defaults of host deployer.
+const (
+	DefaultPollInterval = 5 * time.Second
+	DefaultWaitTimeout  = 5 * time.Minute
+)
+
+// DefaultPendingStatuses are service health check statuses of services not
+// checked yet.
+var DefaultPendingStatuses = []string{"", "UNKNOWN", "PENDING"}
+
+// ErrDeployTimeout is returned when service statuses do not settle before
+// the wait timeout expires. Error of the last poll, if any, is wrapped with
+// it.
+var ErrDeployTimeout = errors.New("timeout waiting for host services")
+
+// DeployStep result of host deployment step.
+type DeployStep struct {
+	Name     string        `json:"name"`
+	Status   StepStatus    `json:"status"`
+	Detail   string        `json:"detail,omitempty"`
+	Duration time.Duration `json:"duration"`
+	Error    string        `json:"error,omitempty"`
+}
+
+// DeployResult result of host deployment.
+type DeployResult struct {
+	HostID string `json:"host_id"`
+	// Action is action of host store deploy, e.g. create or update
+	Action  string        `json:"action"`
+	Steps   []DeployStep  `json:"steps"`
+	Healthy bool          `json:"healthy"`
+	Host    *Host         `json:"host,omitempty"`
+	Elapsed time.Duration `json:"elapsed"`
+}
+
+// Step returns result of the named step, nil if the step was not run.
+func (r *DeployResult) Step(name string) *DeployStep {
+	for i := range r.Steps {
+		if r.Steps[i].Name == name {
+			return &r.Steps[i]
+		}
+	}
+	return nil
+}
+
+// DeployerOption function type configuring host deployer.
+type DeployerOption func(*Deployer) *Deployer
+
+// Undeployable marks deployed hosts as undeployable.
+func Undeployable() DeployerOption {
+	return func(d *Deployer) *Deployer {
+		d.deployable = false
+		return d
+	}
+}
+
+// PollInterval sets interval of host status polling.
+func PollInterval(interval time.Duration) DeployerOption {
+	return func(d *Deployer) *Deployer {
+		d.interval = interval
+		return d
+	}
+}
+
+// WaitTimeout sets time to wait for service statuses to settle, zero
+// disables waiting. Failed polls are retried until the timeout expires.
+func WaitTimeout(timeout time.Duration) DeployerOption {
+	return func(d *Deployer) *Deployer {
+		d.timeout = timeout
+		return d
+	}
+}
+
+// DeployHealthyStatuses sets service health check statuses considered healthy.
+func DeployHealthyStatuses(statuses ...string) DeployerOption {
+	return func(d *Deployer) *Deployer {
+		d.healthy = statuses
+		return d
+	}
+}
+
+// PendingStatuses sets service health check statuses of services not
+// checked yet.
+func PendingStatuses(statuses ...string) DeployerOption {
+	return func(d *Deployer) *Deployer {
+		d.pending = statuses
+		return d
+	}
+}
+
+/*
+Deployer registers hosts end to end. It deploys host, adds principals
+missing from the stored host, sets the deploy status and waits until health
+check statuses of host services settle. Steps which are already in the
+desired state are skipped, so deployment can be repeated safely. Host is
+healthy if it has services and all of them are healthy.
+
+	result, err := hoststore.NewDeployer(
+		hoststore.New(auth),
+		hoststore.WaitTimeout(2*time.Minute),
+	).DeployContext(ctx, &host)
+*/
+type Deployer struct {
+	store      Service
+	deployable bool
+	interval   time.Duration
+	timeout    time.Duration
+	healthy    []string
+	pending    []string
+	now        func() time.Time
+	after      func(time.Duration) <-chan time.Time
+}
+
+// NewDeployer creates deployer of hosts.
+func NewDeployer(store Service, opts ...DeployerOption) *Deployer {
+	d := &Deployer{
+		store:      store,
+		deployable: true,
+		interval:   DefaultPollInterval,
+		timeout:    DefaultWaitTimeout,
+		healthy:    DefaultHealthyStatuses,
+		pending:    DefaultPendingStatuses,
+		now:        time.Now,
+		after:      time.After,
+	}
+
+	for _, opt := range opts {
+		d = opt(d)
+	}
+
+	return d
+}
+
+// Deploy deploys the host, see DeployContext.
+func (d *Deployer) Deploy(host *Host) (*DeployResult, error) {
+	return d.DeployContext(context.Background(), host)
+}
+
+// DeployContext deploys the host. The result describes every step run,
+// including the failed one when error is returned. Deployment is cancelled
+// by the context between steps and polls of host status.
+func (d *Deployer) DeployContext(ctx context.Context, host *Host) (*DeployResult, error) {
+	started := d.now()
+	result := &DeployResult{}
+	defer func() { result.Elapsed = d.now().Sub(started) }()
+
+	var current *Host
+	steps := []struct {
+		name string
+		run  func() (StepStatus, string, error)
+	}{
+		{StepDeploy, func() (StepStatus, string, error) {
+			resp, err := d.store.DeployHost(host)
+			if err != nil {
+				return StepFailed, "", err
+			}
+			if resp.ID == "" {
+				return StepFailed, "", errors.New("host store did not return host id")
+			}
+
+			result.HostID, result.Action = resp.ID, resp.Action
+			current, err = d.store.GetHost(resp.ID)
+			if err != nil {
+				return StepFailed, "", err
+			}
+			return StepDone, resp.Action, nil
+		}},
+		{StepPrincipals, func() (StepStatus, string, error) {
+			missing := missingPrincipals(current, host.Principals)
+			if len(missing) == 0 {
+				return StepSkipped, "principals up to date", nil
+			}
+
+			names := make([]string, len(missing))
+			for i, p := range missing {
+				names[i] = p.Principal
+			}
+
+			update := *current
+			update.Principals = append(append([]HostPrincipals{}, current.Principals...), missing...)
+			if err := d.store.UpdateHost(result.HostID, &update); err != nil {
+				return StepFailed, "", err
+			}
+			current = &update
+			return StepDone, "added " + strings.Join(names, ", "), nil
+		}},
+		{StepDeployStatus, func() (StepStatus, string, error) {
+			detail := fmt.Sprintf("deployable %t", d.deployable)
+			if current.Deployable != nil && *current.Deployable == d.deployable {
+				return StepSkipped, detail, nil
+			}
+			if err := d.store.UpdateDeployStatus(result.HostID, d.deployable); err != nil {
+				return StepFailed, "", err
+			}
+			return StepDone, detail, nil
+		}},
+		{StepWait, func() (StepStatus, string, error) {
+			if d.timeout <= 0 {
+				return StepSkipped, "waiting disabled", nil
+			}
+
+			settled, err := d.wait(ctx, result.HostID)
+			if settled != nil {
+				current = settled
+			}
+			if err != nil {
+				return StepFailed, serviceStatuses(current), err
+			}
+			return StepDone, serviceStatuses(current), nil
+		}},
+	}
+
+	for _, step := range steps {
+		at := d.now()
+		status, detail, err := StepFailed, "", ctx.Err()
+		if err == nil {
+			status, detail, err = step.run()
+		}
+
+		s := DeployStep{Name: step.name, Status: status, Detail: detail, Duration: d.now().Sub(at)}
+		if err != nil {
+			s.Error = err.Error()
+		}
+		result.Steps = append(result.Steps, s)
+
+		if err != nil {
+			result.Host = current
+			return result, fmt.Errorf("%s step failed: %w", step.name, err)
+		}
+	}
+
+	result.Host = current
+	result.Healthy = d.isHealthy(current)
+	return result, nil
+}
+
+// wait polls host until statuses of its services settle, timeout expires
+// or context is done. Failed polls are retried until the timeout.
+func (d *Deployer) wait(ctx context.Context, hostID string) (*Host, error) {
+	deadline := d.now().Add(d.timeout)
+
+	var (
+		last    *Host
+		lastErr error
+	)
+	for {
+		host, err := d.store.GetHost(hostID)
+		if lastErr = err; err == nil {
+			last = host
+			if d.isSettled(host) {
+				return host, nil
+			}
+		}
+
+		if !d.now().Before(deadline) {
+			if lastErr != nil {
+				return last, fmt.Errorf("%w: %w", ErrDeployTimeout, lastErr)
+			}
+			return last, ErrDeployTimeout
+		}
+
+		select {
+		case <-ctx.Done():
+			return last, ctx.Err()
+		case <-d.after(min(d.interval, deadline.Sub(d.now()))):
+		}
+	}
+}
+
+// isSettled checks if health checks of all host services are done
+func (d *Deployer) isSettled(host *Host) bool {
+	for _, service := range host.Services {
+		if containsFold(d.pending, service.HealthCheckStatus) {
+			return false
+		}
+	}
+	return true
+}
+
+// isHealthy checks if host has services and all of them are healthy
+func (d *Deployer) isHealthy(host *Host) bool {
+	if host == nil || len(host.Services) == 0 {
+		return false
+	}
+
+	for _, service := range host.Services {
+		if !containsFold(d.healthy, service.HealthCheckStatus) {
+			return false
+		}
+	}
+	return true
+}
+
+// missingPrincipals returns principals missing from host
+func missingPrincipals(host *Host, principals []HostPrincipals) []HostPrincipals {
+	var missing []HostPrincipals
+	for _, p := range principals {
+		exists := false
+		for _, have := range host.Principals {
+			exists = exists || have.Principal == p.Principal
+		}
+		if !exists {
+			missing = append(missing, p)
+		}
+	}
+	return missing
+}
+
+// serviceStatuses formats health check statuses of host services
+func serviceStatuses(host *Host) string {
+	if host == nil {
+		return ""
+	}
+
+	statuses := make([]string, len(host.Services))
+	for i, service := range host.Services {
+		status := service.HealthCheckStatus
+		if status == "" {
+			status = "-"
+		}
+		statuses[i] = service.Service + " " + status
+	}
+	return strings.Join(statuses, ", ")
+}
+
+// containsFold checks if list contains the value ignoring case
+func containsFold(list []string, value string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
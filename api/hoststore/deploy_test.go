@@ -0,0 +1,189 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package hoststore
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// tClock is fake clock of deployer, waiting advances the clock
+type tClock struct {
+	now   time.Time
+	waits int
+}
+
+// fakeClock sets fake clock to deployer
+func fakeClock(d *Deployer) *tClock {
+	clock := &tClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+	d.now = func() time.Time { return clock.now }
+	d.after = func(wait time.Duration) <-chan time.Time {
+		clock.waits++
+		clock.now = clock.now.Add(wait)
+		ch := make(chan time.Time, 1)
+		ch <- clock.now
+		return ch
+	}
+	return clock
+}
+
+// deployStore is host store deploying single host, service status of the
+// host changes to the next of the given statuses on each poll
+func deployStore(stored *Host, statuses []string, calls *[]string) *Fake {
+	return &Fake{
+		DeployHostFunc: func(host *Host) (HostResponse, error) {
+			*calls = append(*calls, "deploy")
+			return HostResponse{ID: stored.ID, Action: "update"}, nil
+		},
+		GetHostFunc: func(hostID string) (*Host, error) {
+			if len(statuses) > 0 {
+				stored.Services[0].HealthCheckStatus = statuses[0]
+				statuses = statuses[1:]
+			}
+			host := *stored
+			host.Services = append([]HostService{}, stored.Services...)
+			return &host, nil
+		},
+		UpdateHostFunc: func(hostID string, host *Host) error {
+			*calls = append(*calls, "update")
+			stored.Principals = host.Principals
+			return nil
+		},
+		UpdateDeployStatusFunc: func(hostID string, deployable bool) error {
+			*calls = append(*calls, "deployable")
+			stored.Deployable = &deployable
+			return nil
+		},
+	}
+}
+
+func TestDeploy(t *testing.T) {
+	stored := &Host{
+		ID:         "1",
+		CommonName: "web",
+		Services:   []HostService{{Service: "SSH", Address: "10.0.0.1", Port: 22}},
+		Principals: []HostPrincipals{{Principal: "root"}},
+	}
+	desired := Host{
+		CommonName: "web",
+		Addresses:  []string{"10.0.0.1"},
+		Services:   []HostService{{Service: "SSH", Address: "10.0.0.1", Port: 22}},
+		Principals: []HostPrincipals{{Principal: "root"}, {Principal: "deploy"}},
+	}
+
+	var calls []string
+	store := deployStore(stored, []string{"", "", "UNKNOWN", "OK"}, &calls)
+	deployer := NewDeployer(store)
+	fakeClock(deployer)
+
+	result, err := deployer.Deploy(&desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Healthy || result.HostID != "1" || len(result.Steps) != 4 {
+		t.Fatalf("unexpected result %+v", result)
+	}
+	if step := result.Step(StepPrincipals); step.Status != StepDone || step.Detail != "added deploy" {
+		t.Errorf("unexpected principals step %+v", step)
+	}
+	if len(calls) != 3 {
+		t.Errorf("unexpected calls %v", calls)
+	}
+
+	calls = nil
+	result, err = deployer.Deploy(&desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Step(StepPrincipals).Status != StepSkipped || result.Step(StepDeployStatus).Status != StepSkipped {
+		t.Errorf("repeated deployment not skipped %+v", result.Steps)
+	}
+	if len(calls) != 1 {
+		t.Errorf("unexpected calls %v", calls)
+	}
+}
+
+func TestDeployTimeout(t *testing.T) {
+	stored := &Host{ID: "1", Services: []HostService{{Service: "SSH", Address: "10.0.0.1", Port: 22}}}
+	desired := Host{Addresses: []string{"10.0.0.1"}, Services: stored.Services}
+
+	var calls []string
+	deployer := NewDeployer(deployStore(stored, nil, &calls), PollInterval(time.Second), WaitTimeout(10*time.Second))
+	clock := fakeClock(deployer)
+
+	result, err := deployer.Deploy(&desired)
+	if !errors.Is(err, ErrDeployTimeout) {
+		t.Fatalf("expected timeout, got %v", err)
+	}
+	if step := result.Step(StepWait); step == nil || step.Status != StepFailed || result.Healthy {
+		t.Errorf("unexpected result %+v", result)
+	}
+	if clock.waits != 10 || result.Elapsed != 10*time.Second {
+		t.Errorf("unexpected waits %d, elapsed %v", clock.waits, result.Elapsed)
+	}
+}
+
+func TestDeployRetry(t *testing.T) {
+	stored := &Host{ID: "1", Services: []HostService{{Service: "SSH", Address: "10.0.0.1", Port: 22, HealthCheckStatus: "OK"}}}
+
+	var calls []string
+	store := deployStore(stored, nil, &calls)
+	get := store.GetHostFunc
+	polls := 0
+	store.GetHostFunc = func(hostID string) (*Host, error) {
+		polls++
+		if polls == 2 {
+			return nil, errors.New("temporary failure")
+		}
+		return get(hostID)
+	}
+
+	deployer := NewDeployer(store)
+	fakeClock(deployer)
+
+	result, err := deployer.Deploy(&Host{Services: stored.Services})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.Healthy || polls != 3 {
+		t.Errorf("unexpected result %+v after %d polls", result, polls)
+	}
+}
+
+func TestDeployContext(t *testing.T) {
+	stored := &Host{ID: "1", Services: []HostService{{Service: "SSH", Address: "10.0.0.1", Port: 22}}}
+
+	var calls []string
+	deployer := NewDeployer(deployStore(stored, nil, &calls))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	deployer.after = func(time.Duration) <-chan time.Time {
+		cancel()
+		return make(chan time.Time)
+	}
+
+	result, err := deployer.DeployContext(ctx, &Host{Services: stored.Services})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected cancellation, got %v", err)
+	}
+	if step := result.Step(StepWait); step == nil || step.Status != StepFailed {
+		t.Errorf("unexpected result %+v", result)
+	}
+
+	if _, err := deployer.DeployContext(ctx, &Host{}); !errors.Is(err, context.Canceled) || len(calls) != 2 {
+		t.Errorf("cancelled deployment is run: %v %v", err, calls)
+	}
+}
+
+func TestDeployHealthy(t *testing.T) {
+	deployer := NewDeployer(nil)
+	if deployer.isHealthy(&Host{}) {
+		t.Error("host without services is healthy")
+	}
+}
@@ -0,0 +1,385 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package hoststore
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
+)
+
+// listSeparator separates values of list fields in CSV
+const listSeparator = ";"
+
+// DefaultCSVFields are host fields exported to CSV by default.
+var DefaultCSVFields = []string{
+	"id",
+	"common_name",
+	"external_id",
+	"addresses",
+	"tags",
+	"cloud_provider",
+	"cloud_provider_region",
+	"services",
+	"principals",
+}
+
+// AllHosts fetches all hosts matching the search, nil search matches all hosts.
+func AllHosts(store Service, search *HostSearch) ([]Host, error) {
+	return searchAllHosts(store, search, DefaultPageSize)
+}
+
+/*
+ImportCSV reads hosts from CSV with header row. Mapping maps column names to
+host fields, columns missing from mapping are matched to host fields by
+name, e.g. common_name. Other columns are ignored.
+
+Host fields are named by their JSON names. List fields, e.g. addresses and
+tags, are separated by semicolon. Services are defined as SERVICE:port or
+SERVICE@address:port, the service address defaults to the first address
+of host. Principals are defined by names.
+
+	hosts, err := hoststore.ImportCSV(file, map[string]string{
+		"Hostname": "common_name",
+		"IP":       "addresses",
+		"CI":       "external_id",
+	})
+*/
+func ImportCSV(r io.Reader, mapping map[string]string) ([]Host, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+	}
+
+	fields := make([]string, len(header))
+	for i, column := range header {
+		column = strings.TrimSpace(column)
+		if field, ok := mapping[column]; ok {
+			fields[i] = field
+		} else if isHostField(column) {
+			fields[i] = column
+		}
+	}
+
+	for _, field := range mapping {
+		if !isHostField(field) {
+			return nil, fmt.Errorf("unknown host field %s", field)
+		}
+	}
+
+	var hosts []Host
+	for row := 2; ; row++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return hosts, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		var host Host
+		for i, value := range record {
+			if i >= len(fields) || fields[i] == "" {
+				continue
+			}
+			if err := setHostField(&host, fields[i], strings.TrimSpace(value)); err != nil {
+				return nil, fmt.Errorf("row %d: %w", row, err)
+			}
+		}
+
+		for i := range host.Services {
+			if host.Services[i].Address == "" && len(host.Addresses) > 0 {
+				host.Services[i].Address = host.Addresses[0]
+			}
+		}
+		hosts = append(hosts, host)
+	}
+}
+
+// ExportCSV writes hosts to CSV with header row of host fields, the fields
+// default to DefaultCSVFields.
+func ExportCSV(w io.Writer, hosts []Host, fields ...string) error {
+	if len(fields) == 0 {
+		fields = DefaultCSVFields
+	}
+
+	for _, field := range fields {
+		if !isHostField(field) {
+			return fmt.Errorf("unknown host field %s", field)
+		}
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(fields); err != nil {
+		return err
+	}
+
+	for i := range hosts {
+		record := make([]string, len(fields))
+		for j, field := range fields {
+			record[j] = hostField(&hosts[i], field)
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// ImportJSON reads hosts from JSON list of hosts or host store result set.
+func ImportJSON(r io.Reader) ([]Host, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var hosts []Host
+	if err := json.Unmarshal(data, &hosts); err == nil {
+		return hosts, nil
+	}
+
+	var set response.ResultSet[Host]
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, err
+	}
+	return set.Items, nil
+}
+
+// ExportJSON writes hosts as indented JSON list.
+func ExportJSON(w io.Writer, hosts []Host) error {
+	if hosts == nil {
+		hosts = []Host{}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(hosts)
+}
+
+// isHostField checks if host field is supported by CSV
+func isHostField(name string) bool {
+	switch name {
+	case "services", "principals":
+		return true
+	}
+
+	f, ok := hostFieldByName(name)
+	if !ok {
+		return false
+	}
+
+	switch indirect(f.Type).Kind() {
+	case reflect.String, reflect.Bool:
+		return true
+	case reflect.Slice:
+		return f.Type.Elem().Kind() == reflect.String
+	case reflect.Struct:
+		return f.Type == reflect.TypeOf(response.Timestamp{})
+	}
+	return false
+}
+
+// hostFieldByName returns host field by its JSON name
+func hostFieldByName(name string) (reflect.StructField, bool) {
+	t := reflect.TypeOf(Host{})
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if tag == name {
+			return f, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// setHostField parses CSV value of host field
+func setHostField(host *Host, name, value string) error {
+	if value == "" {
+		return nil
+	}
+
+	switch name {
+	case "services":
+		for _, spec := range splitList(value) {
+			service, err := parseService(spec)
+			if err != nil {
+				return err
+			}
+			host.Services = append(host.Services, service)
+		}
+		return nil
+	case "principals":
+		for _, principal := range splitList(value) {
+			host.Principals = append(host.Principals, HostPrincipals{Principal: principal})
+		}
+		return nil
+	}
+
+	f, _ := hostFieldByName(name)
+	v := reflect.ValueOf(host).Elem().FieldByIndex(f.Index)
+
+	if f.Type == reflect.TypeOf(response.Timestamp{}) {
+		ts, err := response.ParseTimestamp(value)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		v.Set(reflect.ValueOf(ts))
+		return nil
+	}
+
+	if v.Kind() == reflect.Pointer {
+		v.Set(reflect.New(f.Type.Elem()))
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		v.SetBool(b)
+	case reflect.Slice:
+		items := splitList(value)
+		list := reflect.MakeSlice(v.Type(), len(items), len(items))
+		for i, item := range items {
+			list.Index(i).SetString(item)
+		}
+		v.Set(list)
+	}
+
+	return nil
+}
+
+// hostField formats host field as CSV value
+func hostField(host *Host, name string) string {
+	switch name {
+	case "services":
+		specs := make([]string, len(host.Services))
+		for i, service := range host.Services {
+			specs[i] = formatService(service, host.Addresses)
+		}
+		return strings.Join(specs, listSeparator)
+	case "principals":
+		names := make([]string, len(host.Principals))
+		for i, principal := range host.Principals {
+			names[i] = principal.Principal
+		}
+		return strings.Join(names, listSeparator)
+	}
+
+	f, _ := hostFieldByName(name)
+	v := reflect.ValueOf(host).Elem().FieldByIndex(f.Index)
+
+	if ts, ok := v.Interface().(response.Timestamp); ok {
+		return ts.String()
+	}
+
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Slice:
+		items := make([]string, v.Len())
+		for i := range items {
+			items[i] = v.Index(i).String()
+		}
+		return strings.Join(items, listSeparator)
+	}
+	return ""
+}
+
+// parseService parses service definition SERVICE[@address][:port]
+func parseService(spec string) (HostService, error) {
+	name, target, hasAddress := strings.Cut(spec, "@")
+	if !hasAddress {
+		name, target, _ = strings.Cut(spec, ":")
+		target = ":" + target
+	}
+
+	service := HostService{Service: strings.ToUpper(strings.TrimSpace(name))}
+	if service.Service == "" {
+		return service, fmt.Errorf("invalid service %q", spec)
+	}
+
+	address, port, err := net.SplitHostPort(target)
+	if err != nil {
+		address, port = target, ""
+	}
+
+	service.Address = address
+	if port != "" {
+		if service.Port, err = strconv.Atoi(port); err != nil {
+			return service, fmt.Errorf("invalid service %q: %w", spec, err)
+		}
+	}
+
+	return service, nil
+}
+
+// formatService formats service definition, address is omitted if it is
+// the first address of host
+func formatService(service HostService, addresses []string) string {
+	port := ""
+	if service.Port != 0 {
+		port = strconv.Itoa(service.Port)
+	}
+
+	if service.Address == "" || (len(addresses) > 0 && service.Address == addresses[0]) {
+		if port == "" {
+			return service.Service
+		}
+		return service.Service + ":" + port
+	}
+
+	if port == "" {
+		return service.Service + "@" + service.Address
+	}
+	return service.Service + "@" + net.JoinHostPort(service.Address, port)
+}
+
+// splitList splits list value, empty items are dropped
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, listSeparator) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// indirect returns the type pointer points to
+func indirect(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
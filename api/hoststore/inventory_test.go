@@ -0,0 +1,216 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package hoststore
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestImportCSV(t *testing.T) {
+	input := `Hostname,CI,IP,services,tags,Owner
+web,ci-1,10.0.0.1;10.0.0.2,SSH:2222;RDP@10.0.0.9:3389,prod;eu,alice
+`
+	hosts, err := ImportCSV(strings.NewReader(input), map[string]string{
+		"Hostname": "common_name",
+		"CI":       "external_id",
+		"IP":       "addresses",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := []Host{{
+		CommonName: "web",
+		ExternalID: "ci-1",
+		Addresses:  []string{"10.0.0.1", "10.0.0.2"},
+		Tags:       []string{"prod", "eu"},
+		Services: []HostService{
+			{Service: "SSH", Address: "10.0.0.1", Port: 2222},
+			{Service: "RDP", Address: "10.0.0.9", Port: 3389},
+		},
+	}}
+	if !reflect.DeepEqual(hosts, expect) {
+		t.Errorf("unexpected hosts: %+v", hosts)
+	}
+
+	var out bytes.Buffer
+	if err := ExportCSV(&out, hosts, "common_name", "addresses", "services"); err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != "common_name,addresses,services\nweb,10.0.0.1;10.0.0.2,SSH:2222;RDP@10.0.0.9:3389\n" {
+		t.Errorf("unexpected CSV: %s", out.String())
+	}
+
+	if _, err := ImportCSV(strings.NewReader(input), map[string]string{"IP": "ip"}); err == nil {
+		t.Error("unknown field is accepted")
+	}
+}
+
+func TestImportJSON(t *testing.T) {
+	for _, input := range []string{
+		`[{"common_name": "web"}]`,
+		`{"count": 1, "items": [{"common_name": "web"}]}`,
+	} {
+		hosts, err := ImportJSON(strings.NewReader(input))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(hosts) != 1 || hosts[0].CommonName != "web" {
+			t.Errorf("unexpected hosts: %+v", hosts)
+		}
+	}
+}
+
+func TestAnsible(t *testing.T) {
+	hosts := []Host{
+		{
+			ID:            "1",
+			CommonName:    "web",
+			Addresses:     []string{"10.0.0.1"},
+			Tags:          []string{"Prod"},
+			CloudProvider: "AWS",
+			Services:      []HostService{{Service: "SSH", Address: "10.0.0.1", Port: 2222}},
+			Principals:    []HostPrincipals{{Principal: "deploy"}},
+		},
+		{
+			ID:         "2",
+			CommonName: "db",
+			Addresses:  []string{"10.0.0.2"},
+			Services:   []HostService{{Service: "SSH", Address: "10.0.0.2", Port: 22}},
+		},
+	}
+
+	var yml bytes.Buffer
+	if err := ExportAnsible(&yml, hosts); err != nil {
+		t.Fatal(err)
+	}
+
+	imported, err := ImportAnsible(&yml)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := []Host{hosts[1], hosts[0]}
+	if !reflect.DeepEqual(imported, expect) {
+		t.Errorf("unexpected hosts:\n%+v\n%+v", imported, expect)
+	}
+
+	var out bytes.Buffer
+	if err := ExportAnsibleInventory(&out, hosts); err != nil {
+		t.Fatal(err)
+	}
+
+	var inventory struct {
+		Meta struct {
+			HostVars map[string]map[string]any `json:"hostvars"`
+		} `json:"_meta"`
+		All       struct{ Children []string } `json:"all"`
+		Ungrouped struct{ Hosts []string }    `json:"ungrouped"`
+		Prod      struct{ Hosts []string }    `json:"tagx__50rod"`
+		AWS       struct{ Hosts []string }    `json:"cloud_aws"`
+	}
+	if err := json.Unmarshal(out.Bytes(), &inventory); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(inventory.All.Children, []string{"ungrouped", "cloud_aws", "tagx__50rod"}) ||
+		!reflect.DeepEqual(inventory.Ungrouped.Hosts, []string{"db"}) ||
+		!reflect.DeepEqual(inventory.Prod.Hosts, []string{"web"}) ||
+		!reflect.DeepEqual(inventory.AWS.Hosts, []string{"web"}) {
+		t.Errorf("unexpected inventory: %s", out.String())
+	}
+	if inventory.Meta.HostVars["web"]["ansible_port"] != float64(2222) {
+		t.Errorf("unexpected host vars: %v", inventory.Meta.HostVars["web"])
+	}
+}
+
+func TestImportAnsiblePrecedence(t *testing.T) {
+	inventory := `
+all:
+  vars:
+    ansible_user: root
+    ansible_port: 22
+  children:
+    prod:
+      vars:
+        ansible_port: 2200
+        ansible_user: admin
+      children:
+        web:
+          vars:
+            ansible_port: 2222
+    web:
+      hosts:
+        web1:
+          ansible_host: 10.0.0.1
+    zone:
+      vars:
+        ansible_group_priority: 5
+        ansible_user: ops
+      hosts:
+        web1:
+    a:
+      hosts:
+        web1:
+          ansible_host: 10.0.0.9
+    tagx_a_2db:
+      hosts:
+        web1:
+    tag_x_41:
+      hosts:
+        web1:
+    tagx_x_4:
+      hosts:
+        web1:
+`
+	for i := 0; i < 10; i++ {
+		hosts, err := ImportAnsible(strings.NewReader(inventory))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(hosts) != 1 {
+			t.Fatalf("unexpected hosts: %+v", hosts)
+		}
+
+		host := hosts[0]
+		if !reflect.DeepEqual(host.Addresses, []string{"10.0.0.1"}) ||
+			host.Services[0].Port != 2222 ||
+			host.Principals[0].Principal != "ops" {
+			t.Errorf("unexpected precedence: %+v", host)
+		}
+		if !reflect.DeepEqual(host.Tags, []string{"a", "a-b", "prod", "tagx_x_4", "web", "x_41", "zone"}) {
+			t.Errorf("unexpected tags: %v", host.Tags)
+		}
+	}
+
+	for _, tag := range []string{"Web-1 é_x", "xA", "x_41", "a__b"} {
+		if decoded, ok := decodeAnsibleGroup(encodeAnsibleGroup(tag)); !ok || decoded != tag {
+			t.Errorf("tag %s does not round-trip: %s", tag, decoded)
+		}
+	}
+	for _, name := range []string{"x_4", "x_41_", "x_61", "x_4A", "Web"} {
+		if tag, ok := decodeAnsibleGroup(name); ok {
+			t.Errorf("group %s is decoded as %s", name, tag)
+		}
+	}
+}
+
+func TestExportAnsibleHostnames(t *testing.T) {
+	hosts := []Host{{ID: "1", CommonName: "web"}, {ID: "2", CommonName: "web"}}
+
+	var out bytes.Buffer
+	if err := ExportAnsible(&out, hosts); err == nil {
+		t.Error("hosts sharing hostname are exported")
+	}
+	if err := ExportAnsibleInventory(&out, hosts); err == nil {
+		t.Error("hosts sharing hostname are exported")
+	}
+}
@@ -0,0 +1,234 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package hoststore
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SSHcom/privx-sdk-go/v2/api/authorizer"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+/*
+KnownHosts renders OpenSSH known_hosts lines of host public keys. The lines
+list addresses and contact address of host, with port of SSH service if it
+is not 22. Hashed lines hide the addresses, one line is rendered per address
+and key as OpenSSH does.
+
+	lines, err := hoststore.KnownHosts(&host, false)
+*/
+func KnownHosts(host *Host, hashed bool) ([]string, error) {
+	addresses := knownHostsAddresses(host)
+	if len(addresses) == 0 {
+		return nil, fmt.Errorf("host %s has no addresses", host.ID)
+	}
+
+	var lines []string
+	for _, pub := range host.SSHHostPubKeys {
+		key, err := parsePublicKey(pub.Key)
+		if err != nil {
+			return nil, fmt.Errorf("host %s: %w", host.ID, err)
+		}
+
+		if !hashed {
+			lines = append(lines, knownhosts.Line(addresses, key))
+			continue
+		}
+
+		for _, address := range addresses {
+			lines = append(lines, knownhosts.HashHostname(address)+" "+
+				strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key))))
+		}
+	}
+
+	return lines, nil
+}
+
+// WriteKnownHosts writes known_hosts lines of hosts, hosts without public
+// keys are skipped.
+func WriteKnownHosts(w io.Writer, hosts []Host, hashed bool) error {
+	for i := range hosts {
+		if len(hosts[i].SSHHostPubKeys) == 0 {
+			continue
+		}
+
+		lines, err := KnownHosts(&hosts[i], hashed)
+		if err != nil {
+			return err
+		}
+		for _, line := range lines {
+			if _, err := fmt.Fprintln(w, line); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+/*
+CertAuthorities renders OpenSSH @cert-authority lines of authorizer CAs,
+trusting host certificates signed by the CAs for hosts matching the
+patterns. Patterns default to all hosts.
+
+	cas, err := authorizer.New(auth).GetCACertificates()
+	if err != nil {
+		return err
+	}
+
+	lines, err := hoststore.CertAuthorities(cas.Items, "*.example.com")
+*/
+func CertAuthorities(cas []authorizer.CA, patterns ...string) ([]string, error) {
+	if len(patterns) == 0 {
+		patterns = []string{"*"}
+	}
+
+	keys, err := CAPublicKeys(cas)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]string, 0, len(cas))
+	for i, ca := range cas {
+		key := keys[i]
+		line := "@cert-authority " + strings.Join(patterns, ",") + " " +
+			strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key)))
+		if ca.Comment != "" {
+			line += " " + ca.Comment
+		}
+		lines = append(lines, line)
+	}
+
+	return lines, nil
+}
+
+// CAPublicKeys parses SSH public keys of authorizer CAs, e.g. authorities of
+// VerifyHostCertificate.
+func CAPublicKeys(cas []authorizer.CA) ([]ssh.PublicKey, error) {
+	keys := make([]ssh.PublicKey, 0, len(cas))
+	for _, ca := range cas {
+		key, err := parsePublicKey(ca.PublicKeyString)
+		if err != nil {
+			key, err = parsePublicKey(ca.PublicKey)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("CA %s: %w", ca.ID, err)
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// ParseHostCertificate parses OpenSSH host certificate, e.g.
+// HostCertificateRaw of host.
+func ParseHostCertificate(raw string) (*ssh.Certificate, error) {
+	key, err := parsePublicKey(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, ok := key.(*ssh.Certificate)
+	if !ok {
+		return nil, fmt.Errorf("%s key is not a certificate", key.Type())
+	}
+	if cert.CertType != ssh.HostCert {
+		return nil, errors.New("certificate is not a host certificate")
+	}
+
+	return cert, nil
+}
+
+/*
+VerifyHostCertificate checks that host certificate is valid for the
+hostname at the given time and it is signed by one of the authorities. At
+least one authority is required.
+
+	cas, err := authorizer.New(auth).GetCACertificates()
+	if err != nil {
+		return err
+	}
+	authorities, err := hoststore.CAPublicKeys(cas.Items)
+	if err != nil {
+		return err
+	}
+
+	cert, err := hoststore.ParseHostCertificate(host.HostCertificateRaw)
+	if err != nil {
+		return err
+	}
+
+	err = hoststore.VerifyHostCertificate(cert, host.CommonName, time.Now(), authorities...)
+*/
+func VerifyHostCertificate(cert *ssh.Certificate, hostname string, at time.Time, authorities ...ssh.PublicKey) error {
+	if cert.CertType != ssh.HostCert {
+		return errors.New("certificate is not a host certificate")
+	}
+	if len(authorities) == 0 {
+		return errors.New("trusted authorities are required")
+	}
+
+	trusted := false
+	for _, authority := range authorities {
+		trusted = trusted || bytes.Equal(authority.Marshal(), cert.SignatureKey.Marshal())
+	}
+	if !trusted {
+		return fmt.Errorf("certificate of %s is not signed by trusted authority", hostname)
+	}
+
+	checker := &ssh.CertChecker{Clock: func() time.Time { return at }}
+	return checker.CheckCert(hostname, cert)
+}
+
+// knownHostsAddresses returns addresses of host for known_hosts
+func knownHostsAddresses(host *Host) []string {
+	port := 22
+	if service := findService(host, "SSH"); service != nil && service.Port != 0 {
+		port = service.Port
+	}
+
+	var addresses []string
+	for _, address := range append(append([]string{}, host.Addresses...), host.ContactAddress) {
+		if address == "" {
+			continue
+		}
+		address = knownhosts.Normalize(net.JoinHostPort(address, strconv.Itoa(port)))
+		if !contains(addresses, address) {
+			addresses = append(addresses, address)
+		}
+	}
+
+	return addresses
+}
+
+// parsePublicKey parses OpenSSH public key either in authorized_keys
+// format or as plain base64 wire format
+func parsePublicKey(text string) (ssh.PublicKey, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, errors.New("empty public key")
+	}
+
+	if key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(text)); err == nil {
+		return key, nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	return ssh.ParsePublicKey(data)
+}
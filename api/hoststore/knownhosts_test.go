@@ -0,0 +1,136 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package hoststore
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SSHcom/privx-sdk-go/v2/api/authorizer"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// newSigner generates ed25519 signer
+func newSigner(t *testing.T) ssh.Signer {
+	t.Helper()
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signer, err := ssh.NewSignerFromKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signer
+}
+
+// authorizedKey formats public key in authorized_keys format
+func authorizedKey(key ssh.PublicKey) string {
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key)))
+}
+
+func TestKnownHosts(t *testing.T) {
+	signer := newSigner(t)
+	host := Host{
+		ID:             "1",
+		Addresses:      []string{"10.0.0.1", "web.example.com"},
+		ContactAddress: "10.0.0.1",
+		Services:       []HostService{{Service: "SSH", Port: 2222}},
+		SSHHostPubKeys: []HostSSHPubKeys{{Key: authorizedKey(signer.PublicKey())}},
+	}
+
+	lines, err := KnownHosts(&host, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lines) != 1 || !strings.HasPrefix(lines[0], "[10.0.0.1]:2222,[web.example.com]:2222 ssh-ed25519 ") {
+		t.Fatalf("unexpected known_hosts %q", lines)
+	}
+
+	hashed, err := KnownHosts(&host, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hashed) != 2 || strings.Contains(strings.Join(hashed, "\n"), "example.com") {
+		t.Fatalf("unexpected hashed known_hosts %q", hashed)
+	}
+
+	file := filepath.Join(t.TempDir(), "known_hosts")
+	if err := os.WriteFile(file, []byte(strings.Join(hashed, "\n")+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	callback, err := knownhosts.New(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := &fakeAddr{"10.0.0.1:2222"}
+	if err := callback("web.example.com:2222", addr, signer.PublicKey()); err != nil {
+		t.Errorf("hashed known_hosts rejected host key: %v", err)
+	}
+}
+
+func TestHostCertificate(t *testing.T) {
+	ca, hostKey := newSigner(t), newSigner(t)
+	now := time.Now()
+
+	cert := &ssh.Certificate{
+		Key:             hostKey.PublicKey(),
+		CertType:        ssh.HostCert,
+		ValidPrincipals: []string{"web.example.com"},
+		ValidAfter:      uint64(now.Add(-time.Hour).Unix()),
+		ValidBefore:     uint64(now.Add(time.Hour).Unix()),
+	}
+	if err := cert.SignCert(rand.Reader, ca); err != nil {
+		t.Fatal(err)
+	}
+
+	parsed, err := ParseHostCertificate(authorizedKey(cert))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := VerifyHostCertificate(parsed, "web.example.com", now, ca.PublicKey()); err != nil {
+		t.Errorf("valid certificate rejected: %v", err)
+	}
+	if err := VerifyHostCertificate(parsed, "web.example.com", now); err == nil {
+		t.Error("certificate accepted without authorities")
+	}
+	if err := VerifyHostCertificate(parsed, "db.example.com", now, ca.PublicKey()); err == nil {
+		t.Error("certificate accepted for invalid principal")
+	}
+	if err := VerifyHostCertificate(parsed, "web.example.com", now.Add(2*time.Hour), ca.PublicKey()); err == nil {
+		t.Error("expired certificate accepted")
+	}
+	if err := VerifyHostCertificate(parsed, "web.example.com", now, hostKey.PublicKey()); err == nil {
+		t.Error("certificate of untrusted authority accepted")
+	}
+
+	if _, err := ParseHostCertificate(authorizedKey(hostKey.PublicKey())); err == nil {
+		t.Error("plain public key accepted as certificate")
+	}
+
+	lines, err := CertAuthorities([]authorizer.CA{{ID: "ca", PublicKeyString: authorizedKey(ca.PublicKey()), Comment: "privx"}}, "*.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lines) != 1 || lines[0] != "@cert-authority *.example.com "+authorizedKey(ca.PublicKey())+" privx" {
+		t.Errorf("unexpected cert authorities %q", lines)
+	}
+}
+
+// fakeAddr is remote address of known_hosts callback
+type fakeAddr struct{ addr string }
+
+func (a *fakeAddr) Network() string { return "tcp" }
+func (a *fakeAddr) String() string  { return a.addr }
@@ -9,38 +9,78 @@ package hoststore
 import (
 	"time"
 
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
 	"github.com/SSHcom/privx-sdk-go/v2/api/rolestore"
 	"github.com/SSHcom/privx-sdk-go/v2/api/secretsmanager"
 )
 
+const (
+	// Enumerated values for whitelist pattern types
+	WhitelistGlob  WhitelistType = "glob"
+	WhitelistRegex WhitelistType = "regex"
+)
+
+// WhitelistType definition for type of whitelist patterns.
+type WhitelistType string
+
+const (
+	// Enumerated values for restricted shell variants
+	RShellBash  RShellVariant = "bash"
+	RShellPosix RShellVariant = "posix"
+)
+
+// RShellVariant definition for shell syntax of restricted shell.
+type RShellVariant string
+
+const (
+	// Enumerated values for well-known host types, empty value stands for
+	// regular host. The server may return other host types.
+	HostTypeDefault HostType = ""
+	HostTypeBastion HostType = "BASTION"
+)
+
+// HostType definition for type of host.
+type HostType string
+
+const (
+	// Enumerated values for host disabled status, empty value stands for
+	// host which is not disabled
+	HostNotDisabled       DisabledStatus = "NOT_DISABLED"
+	HostDisabledByAdmin   DisabledStatus = "BY_ADMIN"
+	HostDisabledByLicense DisabledStatus = "BY_LICENSE"
+)
+
+// DisabledStatus definition for reasons of host being disabled.
+type DisabledStatus string
+
 // HostSearch host search request definition.
 type HostSearch struct {
-	ID                    string   `json:"id,omitempty"`
-	Keywords              string   `json:"keywords,omitempty"`
-	DistinguishedName     []string `json:"distinguished_name,omitempty"`
-	ExternalID            string   `json:"external_id,omitempty"`
-	InstanceID            string   `json:"instance_id,omitempty"`
-	SourceID              string   `json:"source_id,omitempty"`
-	CommonName            []string `json:"common_name,omitempty"`
-	Organization          []string `json:"organization,omitempty"`
-	OrganizationalUnit    []string `json:"organizational_unit,omitempty"`
-	Address               []string `json:"address,omitempty"`
-	Service               []string `json:"service,omitempty"`
-	Port                  []int    `json:"port,omitempty"`
-	Zone                  []string `json:"zone,omitempty"`
-	HostType              []string `json:"host_type,omitempty"`
-	HostClassification    []string `json:"host_classification,omitempty"`
-	Role                  []string `json:"role,omitempty"`
-	Scope                 []string `json:"scope,omitempty"`
-	IgnoreDisabledSources bool     `json:"ignore_disabled_sources,omitempty"`
-	Tags                  []string `json:"tags,omitempty"`
-	AccessGroupIDs        []string `json:"access_group_ids,omitempty"`
-	CloudProviders        []string `json:"cloud_providers,omitempty"`
-	CloudProviderRegions  []string `json:"cloud_provider_regions,omitempty"`
-	Deployable            bool     `json:"deployable,omitempty"`
-	Statuses              []string `json:"statuses,omitempty"`
-	Disabled              string   `json:"disabled,omitempty"`
-	Filter                string   `json:"filter,omitempty"`
+	ID                    string         `json:"id,omitempty"`
+	Keywords              string         `json:"keywords,omitempty"`
+	DistinguishedName     []string       `json:"distinguished_name,omitempty"`
+	ExternalID            string         `json:"external_id,omitempty"`
+	InstanceID            string         `json:"instance_id,omitempty"`
+	SourceID              string         `json:"source_id,omitempty"`
+	CommonName            []string       `json:"common_name,omitempty"`
+	Organization          []string       `json:"organization,omitempty"`
+	OrganizationalUnit    []string       `json:"organizational_unit,omitempty"`
+	Address               []string       `json:"address,omitempty"`
+	Service               []string       `json:"service,omitempty"`
+	Port                  []int          `json:"port,omitempty"`
+	Zone                  []string       `json:"zone,omitempty"`
+	HostType              []HostType     `json:"host_type,omitempty"`
+	HostClassification    []string       `json:"host_classification,omitempty"`
+	Role                  []string       `json:"role,omitempty"`
+	Scope                 []string       `json:"scope,omitempty"`
+	IgnoreDisabledSources bool           `json:"ignore_disabled_sources,omitempty"`
+	Tags                  []string       `json:"tags,omitempty"`
+	AccessGroupIDs        []string       `json:"access_group_ids,omitempty"`
+	CloudProviders        []string       `json:"cloud_providers,omitempty"`
+	CloudProviderRegions  []string       `json:"cloud_provider_regions,omitempty"`
+	Deployable            bool           `json:"deployable,omitempty"`
+	Statuses              []string       `json:"statuses,omitempty"`
+	Disabled              DisabledStatus `json:"disabled,omitempty"`
+	Filter                string         `json:"filter,omitempty"`
 }
 
 // SessionRecordingOptions optional host options to disable session recording per feature.
@@ -74,15 +114,15 @@ type HostDisabled struct {
 
 // Whitelist whitelist definition.
 type Whitelist struct {
-	ID                string   `json:"id"`
-	Name              string   `json:"name"`
-	Comment           string   `json:"comment,omitempty"`
-	Type              string   `json:"type"`
-	WhiteListPatterns []string `json:"whitelist_patterns,omitempty"`
-	Author            string   `json:"author"`
-	Created           string   `json:"created"`
-	UpdatedBy         string   `json:"updated_by,omitempty"`
-	Updated           string   `json:"updated,omitempty"`
+	ID                string             `json:"id"`
+	Name              string             `json:"name"`
+	Comment           string             `json:"comment,omitempty"`
+	Type              WhitelistType      `json:"type"`
+	WhiteListPatterns []string           `json:"whitelist_patterns,omitempty"`
+	Author            string             `json:"author"`
+	Created           response.Timestamp `json:"created"`
+	UpdatedBy         string             `json:"updated_by,omitempty"`
+	Updated           response.Timestamp `json:"updated,omitzero"`
 }
 
 // WhitelistSearch whitelist search request definition.
@@ -92,9 +132,9 @@ type WhitelistSearch struct {
 
 // WhitelistEvaluate whitelist evaluate request definition.
 type WhitelistEvaluate struct {
-	WhiteList     Whitelist `json:"whitelist"`
-	RShellVariant string    `json:"rshell_variant"`
-	Commands      []string  `json:"commands"`
+	WhiteList     Whitelist     `json:"whitelist"`
+	RShellVariant RShellVariant `json:"rshell_variant"`
+	Commands      []string      `json:"commands"`
 }
 
 // WhitelistEvaluateResponse white list evaluate response definition.
@@ -127,7 +167,7 @@ type Principal struct {
 
 // Host defines PrivX target
 type Host struct {
-	ID                      string                   `json:"id"`
+	ID                      string                   `json:"id" diff:"-"`
 	Deployable              *bool                    `json:"deployable,omitempty"`
 	Tofu                    *bool                    `json:"tofu,omitempty"`
 	Toch                    *bool                    `json:"toch"` // Trust on Changed Hostkey
@@ -146,26 +186,26 @@ type Host struct {
 	AccessGroupID           string                   `json:"access_group_id"`
 	CloudProvider           string                   `json:"cloud_provider"`
 	CloudProviderRegion     string                   `json:"cloud_provider_region"`
-	Status                  []HostStatus             `json:"status"`
-	Created                 string                   `json:"created"`
-	Updated                 string                   `json:"updated" diff:"-"`
-	UpdatedBy               string                   `json:"updated_by"`
+	Status                  []HostStatus             `json:"status" diff:"-"`
+	Created                 response.Timestamp       `json:"created" diff:"-"`
+	Updated                 response.Timestamp       `json:"updated" diff:"-"`
+	UpdatedBy               string                   `json:"updated_by" diff:"-"`
 	DistinguishedName       string                   `json:"distinguished_name"`
 	CommonName              string                   `json:"common_name"`
 	Organization            string                   `json:"organization"`
 	OrganizationalUnit      string                   `json:"organizational_unit"`
 	Zone                    string                   `json:"zone"`
 	Scope                   []string                 `json:"scope"`
-	HostType                string                   `json:"host_type"`
+	HostType                HostType                 `json:"host_type"`
 	HostClassification      string                   `json:"host_classification"`
 	Comment                 string                   `json:"comment"`
 	Addresses               []string                 `json:"addresses"`
 	AuditEnabled            *bool                    `json:"audit_enabled,omitempty"`
 	Tags                    []string                 `json:"tags"`
 	UserMessage             string                   `json:"user_message"`
-	Disabled                string                   `json:"disabled"`
+	Disabled                DisabledStatus           `json:"disabled"`
 	SessionRecordingOptions *SessionRecordingOptions `json:"session_recording_options,omitempty"`
-	Deleted                 bool                     `json:"deleted,omitempty"`
+	Deleted                 bool                     `json:"deleted,omitempty" diff:"-"`
 }
 
 type HostStatus struct {
@@ -215,7 +255,7 @@ type HostPrincipals struct {
 
 type HostCommandRestrictions struct {
 	Enabled          bool             `json:"enabled"`
-	RShellVariant    string           `json:"rshell_variant,omitempty"`
+	RShellVariant    RShellVariant    `json:"rshell_variant,omitempty"`
 	DefaultWhiteList WhiteListHandle  `json:"default_whitelist"`
 	WhiteLists       []WhiteListGrant `json:"whitelists"`
 	AllowNoMatch     bool             `json:"allow_no_match,omitempty"`
@@ -302,8 +342,8 @@ type HostService struct {
 	LoginRequestUrl              string                           `json:"login_request_url"`
 	LoginRequestPasswordProperty string                           `json:"login_request_password_property"`
 	AuthType                     string                           `json:"auth_type"`
-	HealthCheckStatus            string                           `json:"status"`
-	HealthCheckStatusUpdated     string                           `json:"status_updated"`
+	HealthCheckStatus            string                           `json:"status" diff:"-"`
+	HealthCheckStatusUpdated     response.Timestamp               `json:"status_updated" diff:"-"`
 	AllowedDomains               []string                         `json:"allowed_domains"`
 	Browser                      string                           `json:"browser"`
 	BrowserKioskMode             bool                             `json:"kiosk_mode"`
@@ -317,8 +357,8 @@ type HostService struct {
 	WebIdleTimeLimit             int                              `json:"idle_time_limit"`
 	WebX11Backend                string                           `json:"web_x11_backend"`
 	ServiceVersion               string                           `json:"service_version"`
-	Created                      time.Time                        `json:"created"`
-	Updated                      time.Time                        `json:"updated"`
+	Created                      time.Time                        `json:"created" diff:"-"`
+	Updated                      time.Time                        `json:"updated" diff:"-"`
 	CertificateTemplate          string                           `json:"certificate_template"`
 	AllowModifiedWebParams       bool                             `json:"allow_modified_web_params"`
 	ProtocolVersion              string                           `json:"protocol_version,omitempty"`
@@ -356,17 +396,17 @@ type HostServiceDBParameters struct {
 }
 
 type HostCertificateInfo struct {
-	Subject           string   `json:"subject,omitempty"`
-	Issuer            string   `json:"issuer,omitempty"`
-	Serial            string   `json:"serial,omitempty"`
-	NotBefore         string   `json:"not_before,omitempty"`
-	NotAfter          string   `json:"not_after,omitempty"`
-	DNSNames          []string `json:"dns_names,omitempty"`
-	EmailAddresses    []string `json:"email_addresses,omitempty"`
-	IPAddresses       []string `json:"ip_addresses,omitempty"`
-	URIs              []string `json:"uris,omitempty"`
-	FingerPrintSHA1   string   `json:"fingerprint_sha1,omitempty"`
-	FingerPrintSHA256 string   `json:"fingerprint_sha256,omitempty"`
+	Subject           string             `json:"subject,omitempty"`
+	Issuer            string             `json:"issuer,omitempty"`
+	Serial            string             `json:"serial,omitempty"`
+	NotBefore         response.Timestamp `json:"not_before,omitzero"`
+	NotAfter          response.Timestamp `json:"not_after,omitzero"`
+	DNSNames          []string           `json:"dns_names,omitempty"`
+	EmailAddresses    []string           `json:"email_addresses,omitempty"`
+	IPAddresses       []string           `json:"ip_addresses,omitempty"`
+	URIs              []string           `json:"uris,omitempty"`
+	FingerPrintSHA1   string             `json:"fingerprint_sha1,omitempty"`
+	FingerPrintSHA256 string             `json:"fingerprint_sha256,omitempty"`
 }
 
 type SessionHostCertificateResponse struct {
@@ -0,0 +1,36 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package hoststore
+
+import "github.com/SSHcom/privx-sdk-go/v2/api/filters"
+
+// DefaultPageSize is number of hosts fetched per request when all pages of
+// search results are collected.
+const DefaultPageSize = 100
+
+// searchAllHosts collects all pages of host search results
+func searchAllHosts(store Service, search *HostSearch, pageSize int) ([]Host, error) {
+	if search == nil {
+		search = &HostSearch{}
+	}
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+
+	var hosts []Host
+	for offset := 0; ; offset += pageSize {
+		page, err := store.SearchHosts(search, filters.Paging(offset, pageSize))
+		if err != nil {
+			return nil, err
+		}
+
+		hosts = append(hosts, page.Items...)
+		if len(page.Items) < pageSize || (page.Count > 0 && len(hosts) >= page.Count) {
+			return hosts, nil
+		}
+	}
+}
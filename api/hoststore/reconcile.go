@@ -0,0 +1,409 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package hoststore
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
+)
+
+// Action kinds of planned host changes.
+const (
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// Action definition for kinds of planned host changes.
+type Action string
+
+// FieldDiff difference of host field between current and desired state.
+type FieldDiff struct {
+	Field   string
+	Current any
+	Desired any
+}
+
+// Change planned change of host.
+type Change struct {
+	Action Action
+	Key    string
+	// ID is id of current host, empty for created hosts
+	ID string
+	// Host is the host sent to host store, current host for deleted hosts
+	Host  *Host
+	Diffs []FieldDiff
+}
+
+// Plan changes required to reach the desired state of hosts.
+type Plan struct {
+	Changes []Change
+}
+
+// Empty checks if the plan has no changes.
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// String formats the plan for human review.
+func (p *Plan) String() string {
+	var b strings.Builder
+
+	for _, c := range p.Changes {
+		switch c.Action {
+		case ActionCreate:
+			fmt.Fprintf(&b, "+ %s\n", c.Key)
+		case ActionDelete:
+			fmt.Fprintf(&b, "- %s (%s)\n", c.Key, c.ID)
+		case ActionUpdate:
+			fmt.Fprintf(&b, "~ %s (%s)\n", c.Key, c.ID)
+			for _, d := range c.Diffs {
+				fmt.Fprintf(&b, "    %s: %v -> %v\n", d.Field, d.Current, d.Desired)
+			}
+		}
+	}
+
+	return b.String()
+}
+
+// ReconcilerOption function type configuring reconciler.
+type ReconcilerOption func(*Reconciler) *Reconciler
+
+// KeyBy sets function which identifies the hosts, hosts without key are
+// ignored. By default hosts are identified by ExternalID or CommonName if
+// external id is not defined.
+func KeyBy(key func(*Host) string) ReconcilerOption {
+	return func(r *Reconciler) *Reconciler {
+		if key != nil {
+			r.key = key
+		}
+		return r
+	}
+}
+
+// Scope restricts the current hosts managed by reconciler, e.g. to hosts
+// of single source or access group. Empty search manages all hosts.
+func Scope(search *HostSearch) ReconcilerOption {
+	return func(r *Reconciler) *Reconciler {
+		r.scope = search
+		return r
+	}
+}
+
+// PageSize sets number of hosts fetched per search request.
+func PageSize(size int) ReconcilerOption {
+	return func(r *Reconciler) *Reconciler {
+		r.pageSize = size
+		return r
+	}
+}
+
+// Prune enables deletion of current hosts missing from the desired state.
+// Prune requires Scope, hosts of cloud and directory sources are never
+// deleted since they are managed by the source sync.
+func Prune() ReconcilerOption {
+	return func(r *Reconciler) *Reconciler {
+		r.prune = true
+		return r
+	}
+}
+
+// Fields sets fields managed by reconciler, the fields are set to their
+// desired values even if the values are zero, e.g. audit_enabled false or
+// empty tags. Fields are JSON names of host fields.
+func Fields(fields ...string) ReconcilerOption {
+	return func(r *Reconciler) *Reconciler {
+		for _, field := range fields {
+			r.fields[field] = true
+		}
+		return r
+	}
+}
+
+// DryRun disables changes, Sync only plans them.
+func DryRun() ReconcilerOption {
+	return func(r *Reconciler) *Reconciler {
+		r.dryRun = true
+		return r
+	}
+}
+
+/*
+Reconciler synchronizes host store with the desired state of hosts. Fields
+which are set in desired hosts are compared with current hosts, fields which
+are zero are left unchanged and fields tagged with diff:"-" are ignored.
+Services and principals are compared the same way, so fields managed by the
+server, such as health check status, do not cause changes. Fields which shall be cleared or set to false are listed with Fields.
+
+	r := hoststore.NewReconciler(
+		hoststore.New(auth),
+		hoststore.Scope(&hoststore.HostSearch{Tags: []string{"inventory"}}),
+		hoststore.Fields("audit_enabled", "tags"),
+		hoststore.Prune(),
+		hoststore.DryRun(),
+	)
+
+	plan, err := r.Sync(desired)
+	fmt.Print(plan)
+*/
+type Reconciler struct {
+	store    Service
+	key      func(*Host) string
+	scope    *HostSearch
+	fields   map[string]bool
+	pageSize int
+	prune    bool
+	dryRun   bool
+}
+
+// NewReconciler creates reconciler of host store.
+func NewReconciler(store Service, opts ...ReconcilerOption) *Reconciler {
+	r := &Reconciler{
+		store:    store,
+		key:      hostKey,
+		fields:   map[string]bool{},
+		pageSize: DefaultPageSize,
+	}
+
+	for _, opt := range opts {
+		r = opt(r)
+	}
+
+	return r
+}
+
+// Plan computes changes required to reach the desired state of hosts.
+func (r *Reconciler) Plan(desired []Host) (*Plan, error) {
+	if r.prune && r.scope == nil {
+		return nil, errors.New("prune requires scope of managed hosts")
+	}
+
+	wanted := map[string]*Host{}
+	for i := range desired {
+		key := r.key(&desired[i])
+		if key == "" {
+			continue
+		}
+		if _, exists := wanted[key]; exists {
+			return nil, fmt.Errorf("duplicate desired host %s", key)
+		}
+		wanted[key] = &desired[i]
+	}
+
+	hosts, err := searchAllHosts(r.store, r.scope, r.pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	current := map[string]*Host{}
+	for i := range hosts {
+		key := r.key(&hosts[i])
+		if key == "" {
+			continue
+		}
+		if _, exists := current[key]; exists {
+			return nil, fmt.Errorf("ambiguous current host %s", key)
+		}
+		current[key] = &hosts[i]
+	}
+
+	plan := &Plan{}
+
+	for key, host := range wanted {
+		existing, ok := current[key]
+		if !ok {
+			plan.Changes = append(plan.Changes, Change{Action: ActionCreate, Key: key, Host: host})
+			continue
+		}
+
+		merged, diffs := mergeHost(existing, host, r.fields)
+		if len(diffs) > 0 {
+			plan.Changes = append(plan.Changes, Change{
+				Action: ActionUpdate,
+				Key:    key,
+				ID:     existing.ID,
+				Host:   merged,
+				Diffs:  diffs,
+			})
+		}
+	}
+
+	if r.prune {
+		for key, host := range current {
+			if _, ok := wanted[key]; !ok && host.SourceID == "" {
+				plan.Changes = append(plan.Changes, Change{Action: ActionDelete, Key: key, ID: host.ID, Host: host})
+			}
+		}
+	}
+
+	order := map[Action]int{ActionCreate: 0, ActionUpdate: 1, ActionDelete: 2}
+	sort.Slice(plan.Changes, func(i, j int) bool {
+		a, b := plan.Changes[i], plan.Changes[j]
+		if a.Action != b.Action {
+			return order[a.Action] < order[b.Action]
+		}
+		return a.Key < b.Key
+	})
+
+	return plan, nil
+}
+
+// Apply makes the planned changes, failed changes do not stop the remaining
+// ones. Dry-run reconciler does not make any changes.
+func (r *Reconciler) Apply(plan *Plan) error {
+	if r.dryRun {
+		return nil
+	}
+
+	var errs []error
+	for _, c := range plan.Changes {
+		var err error
+		switch c.Action {
+		case ActionCreate:
+			_, err = r.store.CreateHost(c.Host)
+		case ActionUpdate:
+			err = r.store.UpdateHost(c.ID, c.Host)
+		case ActionDelete:
+			err = r.store.DeleteHost(c.ID)
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to %s host %s: %w", c.Action, c.Key, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// Sync plans and applies changes required to reach the desired state of hosts.
+func (r *Reconciler) Sync(desired []Host) (*Plan, error) {
+	plan, err := r.Plan(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	return plan, r.Apply(plan)
+}
+
+// hostKey identifies host by external id or common name
+func hostKey(host *Host) string {
+	if host.ExternalID != "" {
+		return host.ExternalID
+	}
+	return host.CommonName
+}
+
+// mergeHost overlays fields set in desired host and the explicit fields on
+// current host and returns differences of the fields.
+func mergeHost(current, desired *Host, explicit map[string]bool) (*Host, []FieldDiff) {
+	merged := *current
+	mv := reflect.ValueOf(&merged).Elem()
+	cv := reflect.ValueOf(current).Elem()
+	dv := reflect.ValueOf(desired).Elem()
+	t := mv.Type()
+
+	var diffs []FieldDiff
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() || sf.Tag.Get("diff") == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if name == "" {
+			name = sf.Name
+		}
+
+		want := dv.Field(i)
+		if want.IsZero() && !explicit[name] {
+			continue
+		}
+
+		have := cv.Field(i)
+		if matchValue(have, want) {
+			continue
+		}
+		diffs = append(diffs, FieldDiff{
+			Field:   name,
+			Current: have.Interface(),
+			Desired: want.Interface(),
+		})
+		mv.Field(i).Set(want)
+	}
+
+	return &merged, diffs
+}
+
+// matchValue checks that current value matches the values set in desired
+// one. Zero and diff:"-" fields of desired structs, such as health check
+// status of services, are not compared and empty and nil collections are
+// equal.
+func matchValue(have, want reflect.Value) bool {
+	switch want.Kind() {
+	case reflect.Slice:
+		if have.Len() != want.Len() {
+			return false
+		}
+		for i := 0; i < want.Len(); i++ {
+			if !matchValue(have.Index(i), want.Index(i)) {
+				return false
+			}
+		}
+		return true
+
+	case reflect.Map:
+		if have.Len() != want.Len() {
+			return false
+		}
+		for _, key := range want.MapKeys() {
+			value := have.MapIndex(key)
+			if !value.IsValid() || !matchValue(value, want.MapIndex(key)) {
+				return false
+			}
+		}
+		return true
+
+	case reflect.Pointer:
+		if have.IsNil() || want.IsNil() {
+			return have.IsNil() == want.IsNil()
+		}
+		return matchValue(have.Elem(), want.Elem())
+
+	case reflect.Struct:
+		switch t := want.Interface().(type) {
+		case time.Time:
+			return t.Equal(have.Interface().(time.Time))
+		case response.Timestamp:
+			return t.Equal(have.Interface().(response.Timestamp).Time)
+		}
+
+		t := want.Type()
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			if !sf.IsExported() {
+				return reflect.DeepEqual(have.Interface(), want.Interface())
+			}
+		}
+		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).Tag.Get("diff") == "-" || want.Field(i).IsZero() {
+				continue
+			}
+			if !matchValue(have.Field(i), want.Field(i)) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return reflect.DeepEqual(have.Interface(), want.Interface())
+}
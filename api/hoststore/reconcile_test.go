@@ -0,0 +1,199 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package hoststore
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/SSHcom/privx-sdk-go/v2/api/filters"
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
+)
+
+// fakeStore is host store of the given hosts, it records changes
+func fakeStore(hosts []Host, changes *[]string) *Fake {
+	return &Fake{
+		SearchHostsFunc: func(search *HostSearch, opts ...filters.Option) (*response.ResultSet[Host], error) {
+			params := url.Values{}
+			if err := filters.Apply(&params, opts...); err != nil {
+				return nil, err
+			}
+			offset, _ := strconv.Atoi(params.Get("offset"))
+			limit, _ := strconv.Atoi(params.Get("limit"))
+
+			end := min(offset+limit, len(hosts))
+			return &response.ResultSet[Host]{Count: len(hosts), Items: hosts[min(offset, end):end]}, nil
+		},
+		GetHostFunc: func(hostID string) (*Host, error) {
+			for i := range hosts {
+				if hosts[i].ID == hostID {
+					host := hosts[i]
+					return &host, nil
+				}
+			}
+			return nil, errors.New("host not found")
+		},
+		CreateHostFunc: func(host *Host) (response.Identifier, error) {
+			*changes = append(*changes, "create "+host.CommonName)
+			return response.Identifier{ID: "new"}, nil
+		},
+		UpdateHostFunc: func(id string, host *Host) error {
+			*changes = append(*changes, "update "+id+" "+host.Comment)
+			return nil
+		},
+		DeleteHostFunc: func(id string) error {
+			*changes = append(*changes, "delete "+id)
+			return nil
+		},
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	current := []Host{
+		{ID: "1", ExternalID: "i-1", CommonName: "web", Comment: "old", Tags: []string{"a"}},
+		{ID: "2", CommonName: "db", Comment: "same"},
+		{ID: "3", CommonName: "legacy"},
+		{ID: "4", CommonName: "cloud", SourceID: "aws"},
+	}
+	desired := []Host{
+		{ExternalID: "i-1", Comment: "new"},
+		{CommonName: "db", Comment: "same", Tags: []string{}},
+		{CommonName: "cache"},
+	}
+
+	var changes []string
+	plan, err := NewReconciler(fakeStore(current, &changes), PageSize(2), Scope(&HostSearch{}), Prune()).Sync(desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := []string{"create cache", "update 1 new", "delete 3"}
+	if len(changes) != len(expect) {
+		t.Fatalf("unexpected changes: %v", changes)
+	}
+	for i := range expect {
+		if changes[i] != expect[i] {
+			t.Errorf("unexpected changes: %v\n%s", changes, plan)
+		}
+	}
+
+	update := plan.Changes[1]
+	if len(update.Diffs) != 1 || update.Diffs[0].Field != "comment" {
+		t.Errorf("unexpected diffs: %+v", update.Diffs)
+	}
+	if update.Host.CommonName != "web" || len(update.Host.Tags) != 1 {
+		t.Errorf("current fields are not preserved: %+v", update.Host)
+	}
+}
+
+func TestReconcileDryRun(t *testing.T) {
+	var changes []string
+	plan, err := NewReconciler(
+		fakeStore([]Host{{ID: "1", CommonName: "web"}}, &changes),
+		Scope(&HostSearch{}),
+		Prune(),
+		DryRun(),
+	).Sync(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(changes) != 0 {
+		t.Errorf("dry-run makes changes: %v", changes)
+	}
+	if plan.String() != "- web (1)\n" {
+		t.Errorf("unexpected plan: %q", plan)
+	}
+}
+
+func TestReconcileDuplicate(t *testing.T) {
+	var changes []string
+	_, err := NewReconciler(fakeStore(nil, &changes)).Plan([]Host{{CommonName: "a"}, {CommonName: "a"}})
+	if err == nil {
+		t.Error("duplicate hosts are accepted")
+	}
+}
+
+func TestReconcilePruneScope(t *testing.T) {
+	var changes []string
+	_, err := NewReconciler(fakeStore([]Host{{ID: "1", CommonName: "web"}}, &changes), Prune()).Sync(nil)
+	if err == nil || len(changes) != 0 {
+		t.Errorf("prune without scope is accepted: %v", changes)
+	}
+}
+
+func TestReconcileFields(t *testing.T) {
+	enabled, disabled := true, false
+	current := []Host{{ID: "1", CommonName: "web", Comment: "x", Tags: []string{"a"}, AuditEnabled: &enabled}}
+	desired := []Host{{CommonName: "web", Tags: []string{}, AuditEnabled: &disabled}}
+
+	var changes []string
+	plan, err := NewReconciler(fakeStore(current, &changes), Fields("tags")).Sync(desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(plan.Changes) != 1 || len(plan.Changes[0].Diffs) != 2 {
+		t.Fatalf("unexpected plan: %s", plan)
+	}
+	host := plan.Changes[0].Host
+	if len(host.Tags) != 0 || *host.AuditEnabled || host.Comment != "x" {
+		t.Errorf("unexpected host: %+v", host)
+	}
+}
+
+func TestReconcileConverge(t *testing.T) {
+	desired := []Host{{
+		CommonName: "web",
+		Services:   []HostService{{Service: "SSH", Address: "10.0.0.1", Port: 22}},
+		Principals: []HostPrincipals{{Principal: "root", Roles: []HostRole{{ID: "r1"}}}},
+	}}
+
+	var hosts []Host
+	store := fakeStore(nil, nil)
+	store.SearchHostsFunc = func(search *HostSearch, opts ...filters.Option) (*response.ResultSet[Host], error) {
+		return &response.ResultSet[Host]{Count: len(hosts), Items: hosts}, nil
+	}
+	store.CreateHostFunc = func(host *Host) (response.Identifier, error) {
+		created := *host
+		created.ID = "1"
+		created.Created = response.Timestamp{Time: time.Now()}
+		created.Services = []HostService{host.Services[0]}
+		created.Services[0].HealthCheckStatus = "OK"
+		created.Services[0].HealthCheckStatusUpdated = response.Timestamp{Time: time.Now()}
+		created.Services[0].Created = time.Now()
+		created.Principals = []HostPrincipals{host.Principals[0]}
+		created.Principals[0].Roles = []HostRole{{ID: "r1", Name: "admins"}}
+		hosts = append(hosts, created)
+		return response.Identifier{ID: created.ID}, nil
+	}
+
+	r := NewReconciler(store)
+	if _, err := r.Sync(desired); err != nil || len(hosts) != 1 {
+		t.Fatalf("host is not created: %v", err)
+	}
+
+	plan, err := r.Plan(desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !plan.Empty() {
+		t.Errorf("plan of applied hosts is not empty: %s", plan)
+	}
+
+	desired[0].Services[0].Port = 2222
+	plan, err = r.Plan(desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Changes) != 1 || len(plan.Changes[0].Diffs) != 1 || plan.Changes[0].Diffs[0].Field != "services" {
+		t.Errorf("unexpected plan: %s", plan)
+	}
+}
@@ -0,0 +1,378 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package hoststore
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/SSHcom/privx-sdk-go/v2/api/filters"
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
+)
+
+// Kinds of host report findings.
+const (
+	FindingDisabledHost        Finding = "disabled_host"
+	FindingUnhealthyService    Finding = "unhealthy_service"
+	FindingStaleHealthCheck    Finding = "stale_health_check"
+	FindingExpiringCertificate Finding = "expiring_certificate"
+	FindingExpiredCertificate  Finding = "expired_certificate"
+)
+
+// Finding definition for kinds of host report findings.
+type Finding string
+
+// Defaults of host report builder.
+const (
+	DefaultStaleAfter    = 24 * time.Hour
+	DefaultExpiresWithin = 30 * 24 * time.Hour
+)
+
+// DefaultHealthyStatuses are service health check statuses considered healthy.
+var DefaultHealthyStatuses = []string{"OK", "UP", "HEALTHY"}
+
+// ReportEntry finding of host report.
+type ReportEntry struct {
+	HostID  string             `json:"host_id"`
+	Host    string             `json:"host"`
+	Finding Finding            `json:"finding"`
+	Subject string             `json:"subject,omitempty"`
+	Detail  string             `json:"detail,omitempty"`
+	Time    response.Timestamp `json:"time,omitzero"`
+}
+
+// Report health and certificate expiry report of hosts.
+type Report struct {
+	Generated response.Timestamp `json:"generated"`
+	Hosts     int                `json:"hosts"`
+	Summary   map[Finding]int    `json:"summary"`
+	Entries   []ReportEntry      `json:"entries"`
+}
+
+// reportFields are columns of CSV and text report
+var reportFields = []string{"host_id", "host", "finding", "subject", "detail", "time"}
+
+// record formats report entry as CSV or table record
+func (e *ReportEntry) record() []string {
+	return []string{e.HostID, e.Host, string(e.Finding), e.Subject, e.Detail, e.Time.String()}
+}
+
+// WriteJSON writes the report as indented JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// WriteCSV writes the report entries to CSV with header row.
+func (r *Report) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(reportFields); err != nil {
+		return err
+	}
+
+	for i := range r.Entries {
+		if err := writer.Write(r.Entries[i].record()); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteText writes the report summary and entries as text table.
+func (r *Report) WriteText(w io.Writer) error {
+	if _, err := fmt.Fprintf(w, "Generated %s, %d hosts\n", r.Generated, r.Hosts); err != nil {
+		return err
+	}
+
+	findings := make([]string, 0, len(r.Summary))
+	for finding := range r.Summary {
+		findings = append(findings, string(finding))
+	}
+	sort.Strings(findings)
+	for _, finding := range findings {
+		if _, err := fmt.Fprintf(w, "  %s: %d\n", finding, r.Summary[Finding(finding)]); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+
+	table := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	if _, err := fmt.Fprintln(table, strings.ToUpper(strings.Join(reportFields, "\t"))); err != nil {
+		return err
+	}
+	for i := range r.Entries {
+		if _, err := fmt.Fprintln(table, strings.Join(r.Entries[i].record(), "\t")); err != nil {
+			return err
+		}
+	}
+	return table.Flush()
+}
+
+// ReportOption function type configuring host report builder.
+type ReportOption func(*ReportBuilder) *ReportBuilder
+
+// ReportScope restricts the hosts included in report.
+func ReportScope(search *HostSearch) ReportOption {
+	return func(b *ReportBuilder) *ReportBuilder {
+		b.scope = search
+		return b
+	}
+}
+
+// ReportPageSize sets number of hosts fetched per search request.
+func ReportPageSize(size int) ReportOption {
+	return func(b *ReportBuilder) *ReportBuilder {
+		b.pageSize = size
+		return b
+	}
+}
+
+// StaleAfter sets age of health checks considered stale, zero disables
+// the check.
+func StaleAfter(age time.Duration) ReportOption {
+	return func(b *ReportBuilder) *ReportBuilder {
+		b.staleAfter = age
+		return b
+	}
+}
+
+// ExpiresWithin sets period of certificate expiry reported as expiring.
+func ExpiresWithin(period time.Duration) ReportOption {
+	return func(b *ReportBuilder) *ReportBuilder {
+		b.expiresWithin = period
+		return b
+	}
+}
+
+// HealthyStatuses sets service health check statuses considered healthy.
+func HealthyStatuses(statuses ...string) ReportOption {
+	return func(b *ReportBuilder) *ReportBuilder {
+		b.healthy = statuses
+		return b
+	}
+}
+
+// SessionCertificates includes session host certificates of hosts in
+// report, it costs an additional request per host.
+func SessionCertificates() ReportOption {
+	return func(b *ReportBuilder) *ReportBuilder {
+		b.sessionCerts = true
+		return b
+	}
+}
+
+// ReportTime sets the time report is evaluated at, defaults to now.
+func ReportTime(at time.Time) ReportOption {
+	return func(b *ReportBuilder) *ReportBuilder {
+		b.now = func() time.Time { return at }
+		return b
+	}
+}
+
+/*
+ReportBuilder builds health and certificate expiry report of hosts. The
+report lists disabled hosts, unhealthy services, stale service health
+checks and expired or expiring host certificates.
+
+	report, err := hoststore.NewReportBuilder(
+		hoststore.New(auth),
+		hoststore.ExpiresWithin(14*24*time.Hour),
+		hoststore.SessionCertificates(),
+	).Build()
+	if err != nil {
+		return err
+	}
+
+	report.WriteText(os.Stdout)
+*/
+type ReportBuilder struct {
+	store         Service
+	scope         *HostSearch
+	pageSize      int
+	staleAfter    time.Duration
+	expiresWithin time.Duration
+	healthy       []string
+	sessionCerts  bool
+	now           func() time.Time
+}
+
+// NewReportBuilder creates builder of host reports.
+func NewReportBuilder(store Service, opts ...ReportOption) *ReportBuilder {
+	b := &ReportBuilder{
+		store:         store,
+		pageSize:      DefaultPageSize,
+		staleAfter:    DefaultStaleAfter,
+		expiresWithin: DefaultExpiresWithin,
+		healthy:       DefaultHealthyStatuses,
+		now:           time.Now,
+	}
+
+	for _, opt := range opts {
+		b = opt(b)
+	}
+
+	return b
+}
+
+// Build pages all hosts and builds the report.
+func (b *ReportBuilder) Build() (*Report, error) {
+	hosts, err := searchAllHosts(b.store, b.scope, b.pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	now := b.now()
+	report := &Report{
+		Generated: response.Timestamp{Time: now},
+		Hosts:     len(hosts),
+		Summary:   map[Finding]int{},
+		Entries:   []ReportEntry{},
+	}
+
+	for i := range hosts {
+		entries, err := b.check(&hosts[i], now)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, entry := range entries {
+			report.Summary[entry.Finding]++
+		}
+		report.Entries = append(report.Entries, entries...)
+	}
+
+	return report, nil
+}
+
+// check evaluates host at the given time
+func (b *ReportBuilder) check(host *Host, now time.Time) ([]ReportEntry, error) {
+	var entries []ReportEntry
+	add := func(finding Finding, subject, detail string, at response.Timestamp) {
+		entries = append(entries, ReportEntry{
+			HostID:  host.ID,
+			Host:    host.CommonName,
+			Finding: finding,
+			Subject: subject,
+			Detail:  detail,
+			Time:    at,
+		})
+	}
+
+	if isDisabled(host.Disabled) {
+		add(FindingDisabledHost, "", string(host.Disabled), response.Timestamp{})
+	}
+
+	for _, service := range host.Services {
+		subject := service.Service
+		if service.Address != "" {
+			subject += "@" + net.JoinHostPort(service.Address, strconv.Itoa(service.Port))
+		}
+
+		if service.HealthCheckStatus != "" && !b.isHealthy(service.HealthCheckStatus) {
+			add(FindingUnhealthyService, subject, service.HealthCheckStatus, response.Timestamp{})
+		}
+
+		if b.staleAfter <= 0 {
+			continue
+		}
+		updated := service.HealthCheckStatusUpdated
+		if updated.IsZero() {
+			continue
+		}
+		if age := now.Sub(updated.Time); age > b.staleAfter {
+			add(FindingStaleHealthCheck, subject, "last checked "+age.Truncate(time.Minute).String()+" ago", updated)
+		}
+	}
+
+	if cert := host.HostCertificate; cert != nil {
+		if finding, ok := b.checkExpiry(cert, now); ok {
+			add(finding, "host certificate", cert.Subject, cert.NotAfter)
+		}
+	}
+
+	if b.sessionCerts {
+		certs, err := b.sessionCertificates(host.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get session certificates of host %s: %w", host.ID, err)
+		}
+
+		for _, c := range certs {
+			if c.HostCertificate == nil {
+				continue
+			}
+			if finding, ok := b.checkExpiry(c.HostCertificate, now); ok {
+				add(finding, "session certificate "+c.ID, c.HostCertificate.Subject, c.HostCertificate.NotAfter)
+			}
+		}
+	}
+
+	return entries, nil
+}
+
+// checkExpiry checks if certificate is expired or expiring
+func (b *ReportBuilder) checkExpiry(cert *HostCertificateInfo, now time.Time) (Finding, bool) {
+	if cert.NotAfter.IsZero() {
+		return "", false
+	}
+
+	left := cert.NotAfter.Sub(now)
+	switch {
+	case left <= 0:
+		return FindingExpiredCertificate, true
+	case left <= b.expiresWithin:
+		return FindingExpiringCertificate, true
+	}
+	return "", false
+}
+
+// sessionCertificates collects all pages of session host certificates
+func (b *ReportBuilder) sessionCertificates(hostID string) ([]SessionHostCertificateResponse, error) {
+	pageSize := b.pageSize
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+
+	var certs []SessionHostCertificateResponse
+	for offset := 0; ; offset += pageSize {
+		page, err := b.store.GetSessionHostCertificates(hostID, filters.Paging(offset, pageSize))
+		if err != nil {
+			return nil, err
+		}
+
+		certs = append(certs, page.Items...)
+		if len(page.Items) < pageSize || (page.Count > 0 && len(certs) >= page.Count) {
+			return certs, nil
+		}
+	}
+}
+
+// isHealthy checks if service health check status is healthy
+func (b *ReportBuilder) isHealthy(status string) bool {
+	return containsFold(b.healthy, status)
+}
+
+// isDisabled checks if host disabled status stands for disabled host
+func isDisabled(status DisabledStatus) bool {
+	switch DisabledStatus(strings.ToUpper(string(status))) {
+	case "", HostNotDisabled, "FALSE":
+		return false
+	}
+	return true
+}
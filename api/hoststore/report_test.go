@@ -0,0 +1,129 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package hoststore
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SSHcom/privx-sdk-go/v2/api/filters"
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
+)
+
+func TestReport(t *testing.T) {
+	now := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+	hosts := []Host{
+		{
+			ID:         "1",
+			CommonName: "web",
+			Disabled:   "BY_ADMIN",
+			Services: []HostService{
+				{Service: "SSH", Address: "10.0.0.1", Port: 22, HealthCheckStatus: "OK", HealthCheckStatusUpdated: response.Timestamp{Time: now.Add(-48 * time.Hour)}},
+				{Service: "RDP", Address: "10.0.0.1", Port: 3389, HealthCheckStatus: "DOWN", HealthCheckStatusUpdated: response.Timestamp{Time: now}},
+			},
+			HostCertificate: &HostCertificateInfo{Subject: "CN=web", NotAfter: response.Timestamp{Time: now.Add(24 * time.Hour)}},
+		},
+		{
+			ID:              "2",
+			CommonName:      "db",
+			Disabled:        "NOT_DISABLED",
+			Services:        []HostService{{Service: "SSH", Address: "10.0.0.2", Port: 22, HealthCheckStatus: "ok"}},
+			HostCertificate: &HostCertificateInfo{Subject: "CN=db", NotAfter: response.Timestamp{Time: now.Add(365 * 24 * time.Hour)}},
+		},
+	}
+
+	store := fakeStore(hosts, nil)
+	store.GetSessionHostCertificatesFunc = func(hostID string, opts ...filters.Option) (*response.ResultSet[SessionHostCertificateResponse], error) {
+		if hostID != "2" {
+			return &response.ResultSet[SessionHostCertificateResponse]{}, nil
+		}
+		return &response.ResultSet[SessionHostCertificateResponse]{
+			Count: 1,
+			Items: []SessionHostCertificateResponse{
+				{ID: "s1", HostCertificate: &HostCertificateInfo{NotAfter: response.Timestamp{Time: now.Add(-time.Hour)}}},
+			},
+		}, nil
+	}
+
+	report, err := NewReportBuilder(store, ReportTime(now), ReportPageSize(1), SessionCertificates()).Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := map[Finding]int{
+		FindingDisabledHost:        1,
+		FindingUnhealthyService:    1,
+		FindingStaleHealthCheck:    1,
+		FindingExpiringCertificate: 1,
+		FindingExpiredCertificate:  1,
+	}
+	if report.Hosts != 2 || len(report.Entries) != 5 {
+		t.Fatalf("unexpected report %+v", report)
+	}
+	for finding, count := range expect {
+		if report.Summary[finding] != count {
+			t.Errorf("%s: %d findings, expected %d", finding, report.Summary[finding], count)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := report.WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var decoded Report
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil || len(decoded.Entries) != 5 {
+		t.Errorf("invalid JSON report %v: %s", err, buf.String())
+	}
+
+	buf.Reset()
+	if err := report.WriteCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil || len(records) != 6 || records[0][2] != "finding" {
+		t.Errorf("invalid CSV report %v: %q", err, records)
+	}
+
+	buf.Reset()
+	if err := report.WriteText(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if text := buf.String(); !strings.Contains(text, "unhealthy_service: 1") || !strings.Contains(text, "RDP@10.0.0.1:3389") {
+		t.Errorf("unexpected text report:\n%s", text)
+	}
+}
+
+func TestReportDisabled(t *testing.T) {
+	for status, expect := range map[DisabledStatus]bool{
+		"":             false,
+		"NOT_DISABLED": false,
+		"not_disabled": false,
+		"FALSE":        false,
+		"BY_ADMIN":     true,
+		"BY_LICENSE":   true,
+	} {
+		if isDisabled(status) != expect {
+			t.Errorf("status %q: disabled is not %v", status, expect)
+		}
+	}
+}
+
+type failWriter struct{}
+
+func (failWriter) Write([]byte) (int, error) { return 0, io.ErrShortWrite }
+
+func TestReportWriteTextError(t *testing.T) {
+	report := &Report{Entries: []ReportEntry{{HostID: "1"}}}
+	if err := report.WriteText(failWriter{}); err != io.ErrShortWrite {
+		t.Errorf("write error is not returned: %v", err)
+	}
+}
@@ -0,0 +1,293 @@
+// Code generated by mockgen from HostStore method set; DO NOT EDIT.
+
+package hoststore
+
+import (
+	"errors"
+
+	"github.com/SSHcom/privx-sdk-go/v2/api/filters"
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
+)
+
+// Service is the interface of HostStore client, it allows substitution of
+// the client in tests, see Fake.
+type Service interface {
+	// CreateHost create a host.
+	CreateHost(host *Host) (response.Identifier, error)
+	// CreateWhitelist create whitelist.
+	CreateWhitelist(whitelist *Whitelist) (response.Identifier, error)
+	// DeleteHost delete host.
+	DeleteHost(hostID string) error
+	// DeleteSessionHostCertificate delete session host certificate by host id.
+	DeleteSessionHostCertificate(hostID string, certID string) error
+	// DeleteSessionHostCertificates delete all session host certificates by host id.
+	DeleteSessionHostCertificates(hostID string) error
+	// DeleteWhitelist delete whitelist.
+	DeleteWhitelist(whitelistID string) error
+	// DeployHost deploy host.
+	DeployHost(host *Host) (HostResponse, error)
+	// EvaluateWhitelist evaluate commands against whitelist patterns.
+	EvaluateWhitelist(evaluate *WhitelistEvaluate) (*WhitelistEvaluateResponse, error)
+	// GetHost get host by id.
+	GetHost(hostID string) (*Host, error)
+	// GetHostTags get host tags.
+	GetHostTags(opts ...filters.Option) (*response.ResultSet[string], error)
+	// GetHosts get hosts.
+	GetHosts(opts ...filters.Option) (*response.ResultSet[Host], error)
+	// GetServiceOptions get default service options.
+	GetServiceOptions() (*HostServiceOptions, error)
+	// GetSessionHostCertificates get session host certificates by host id.
+	GetSessionHostCertificates(hostID string, opts ...filters.Option) (*response.ResultSet[SessionHostCertificateResponse], error)
+	// GetWhitelist get whitelist by id.
+	GetWhitelist(whitelistID string) (*Whitelist, error)
+	// GetWhitelists get whitelists.
+	GetWhitelists(opts ...filters.Option) (*response.ResultSet[Whitelist], error)
+	// ResolveHost resolve service to a single host.
+	ResolveHost(resolve HostResolve) (*Host, error)
+	// SearchHosts search hosts.
+	SearchHosts(search *HostSearch, opts ...filters.Option) (*response.ResultSet[Host], error)
+	// SearchWhitelists search whitelists.
+	SearchWhitelists(search WhitelistSearch, opts ...filters.Option) (*response.ResultSet[Whitelist], error)
+	// Status get host store microservice status.
+	Status() (*response.ServiceStatus, error)
+	// UpdateDeployStatus update host to be deployable or undeployable.
+	UpdateDeployStatus(hostID string, deployable bool) error
+	// UpdateHost update host.
+	UpdateHost(hostID string, host *Host) error
+	// UpdateHostStatus enable/disable host.
+	UpdateHostStatus(hostID string, disabled bool) error
+	// UpdateWhitelist update whitelist.
+	UpdateWhitelist(whitelistID string, whitelist Whitelist) error
+}
+
+var (
+	_ Service = (*HostStore)(nil)
+	_ Service = (*Fake)(nil)
+)
+
+// Fake is a fake of HostStore client. Methods delegate to the corresponding
+// function fields, undefined functions return an error.
+type Fake struct {
+	CreateHostFunc                    func(*Host) (response.Identifier, error)
+	CreateWhitelistFunc               func(*Whitelist) (response.Identifier, error)
+	DeleteHostFunc                    func(string) error
+	DeleteSessionHostCertificateFunc  func(string, string) error
+	DeleteSessionHostCertificatesFunc func(string) error
+	DeleteWhitelistFunc               func(string) error
+	DeployHostFunc                    func(*Host) (HostResponse, error)
+	EvaluateWhitelistFunc             func(*WhitelistEvaluate) (*WhitelistEvaluateResponse, error)
+	GetHostFunc                       func(string) (*Host, error)
+	GetHostTagsFunc                   func(...filters.Option) (*response.ResultSet[string], error)
+	GetHostsFunc                      func(...filters.Option) (*response.ResultSet[Host], error)
+	GetServiceOptionsFunc             func() (*HostServiceOptions, error)
+	GetSessionHostCertificatesFunc    func(string, ...filters.Option) (*response.ResultSet[SessionHostCertificateResponse], error)
+	GetWhitelistFunc                  func(string) (*Whitelist, error)
+	GetWhitelistsFunc                 func(...filters.Option) (*response.ResultSet[Whitelist], error)
+	ResolveHostFunc                   func(HostResolve) (*Host, error)
+	SearchHostsFunc                   func(*HostSearch, ...filters.Option) (*response.ResultSet[Host], error)
+	SearchWhitelistsFunc              func(WhitelistSearch, ...filters.Option) (*response.ResultSet[Whitelist], error)
+	StatusFunc                        func() (*response.ServiceStatus, error)
+	UpdateDeployStatusFunc            func(string, bool) error
+	UpdateHostFunc                    func(string, *Host) error
+	UpdateHostStatusFunc              func(string, bool) error
+	UpdateWhitelistFunc               func(string, Whitelist) error
+}
+
+// CreateHost calls CreateHostFunc.
+func (f *Fake) CreateHost(host *Host) (response.Identifier, error) {
+	if f.CreateHostFunc == nil {
+		var r0 response.Identifier
+		return r0, errors.New("hoststore: Fake.CreateHost is not implemented")
+	}
+	return f.CreateHostFunc(host)
+}
+
+// CreateWhitelist calls CreateWhitelistFunc.
+func (f *Fake) CreateWhitelist(whitelist *Whitelist) (response.Identifier, error) {
+	if f.CreateWhitelistFunc == nil {
+		var r0 response.Identifier
+		return r0, errors.New("hoststore: Fake.CreateWhitelist is not implemented")
+	}
+	return f.CreateWhitelistFunc(whitelist)
+}
+
+// DeleteHost calls DeleteHostFunc.
+func (f *Fake) DeleteHost(hostID string) error {
+	if f.DeleteHostFunc == nil {
+		return errors.New("hoststore: Fake.DeleteHost is not implemented")
+	}
+	return f.DeleteHostFunc(hostID)
+}
+
+// DeleteSessionHostCertificate calls DeleteSessionHostCertificateFunc.
+func (f *Fake) DeleteSessionHostCertificate(hostID string, certID string) error {
+	if f.DeleteSessionHostCertificateFunc == nil {
+		return errors.New("hoststore: Fake.DeleteSessionHostCertificate is not implemented")
+	}
+	return f.DeleteSessionHostCertificateFunc(hostID, certID)
+}
+
+// DeleteSessionHostCertificates calls DeleteSessionHostCertificatesFunc.
+func (f *Fake) DeleteSessionHostCertificates(hostID string) error {
+	if f.DeleteSessionHostCertificatesFunc == nil {
+		return errors.New("hoststore: Fake.DeleteSessionHostCertificates is not implemented")
+	}
+	return f.DeleteSessionHostCertificatesFunc(hostID)
+}
+
+// DeleteWhitelist calls DeleteWhitelistFunc.
+func (f *Fake) DeleteWhitelist(whitelistID string) error {
+	if f.DeleteWhitelistFunc == nil {
+		return errors.New("hoststore: Fake.DeleteWhitelist is not implemented")
+	}
+	return f.DeleteWhitelistFunc(whitelistID)
+}
+
+// DeployHost calls DeployHostFunc.
+func (f *Fake) DeployHost(host *Host) (HostResponse, error) {
+	if f.DeployHostFunc == nil {
+		var r0 HostResponse
+		return r0, errors.New("hoststore: Fake.DeployHost is not implemented")
+	}
+	return f.DeployHostFunc(host)
+}
+
+// EvaluateWhitelist calls EvaluateWhitelistFunc.
+func (f *Fake) EvaluateWhitelist(evaluate *WhitelistEvaluate) (*WhitelistEvaluateResponse, error) {
+	if f.EvaluateWhitelistFunc == nil {
+		var r0 *WhitelistEvaluateResponse
+		return r0, errors.New("hoststore: Fake.EvaluateWhitelist is not implemented")
+	}
+	return f.EvaluateWhitelistFunc(evaluate)
+}
+
+// GetHost calls GetHostFunc.
+func (f *Fake) GetHost(hostID string) (*Host, error) {
+	if f.GetHostFunc == nil {
+		var r0 *Host
+		return r0, errors.New("hoststore: Fake.GetHost is not implemented")
+	}
+	return f.GetHostFunc(hostID)
+}
+
+// GetHostTags calls GetHostTagsFunc.
+func (f *Fake) GetHostTags(opts ...filters.Option) (*response.ResultSet[string], error) {
+	if f.GetHostTagsFunc == nil {
+		var r0 *response.ResultSet[string]
+		return r0, errors.New("hoststore: Fake.GetHostTags is not implemented")
+	}
+	return f.GetHostTagsFunc(opts...)
+}
+
+// GetHosts calls GetHostsFunc.
+func (f *Fake) GetHosts(opts ...filters.Option) (*response.ResultSet[Host], error) {
+	if f.GetHostsFunc == nil {
+		var r0 *response.ResultSet[Host]
+		return r0, errors.New("hoststore: Fake.GetHosts is not implemented")
+	}
+	return f.GetHostsFunc(opts...)
+}
+
+// GetServiceOptions calls GetServiceOptionsFunc.
+func (f *Fake) GetServiceOptions() (*HostServiceOptions, error) {
+	if f.GetServiceOptionsFunc == nil {
+		var r0 *HostServiceOptions
+		return r0, errors.New("hoststore: Fake.GetServiceOptions is not implemented")
+	}
+	return f.GetServiceOptionsFunc()
+}
+
+// GetSessionHostCertificates calls GetSessionHostCertificatesFunc.
+func (f *Fake) GetSessionHostCertificates(hostID string, opts ...filters.Option) (*response.ResultSet[SessionHostCertificateResponse], error) {
+	if f.GetSessionHostCertificatesFunc == nil {
+		var r0 *response.ResultSet[SessionHostCertificateResponse]
+		return r0, errors.New("hoststore: Fake.GetSessionHostCertificates is not implemented")
+	}
+	return f.GetSessionHostCertificatesFunc(hostID, opts...)
+}
+
+// GetWhitelist calls GetWhitelistFunc.
+func (f *Fake) GetWhitelist(whitelistID string) (*Whitelist, error) {
+	if f.GetWhitelistFunc == nil {
+		var r0 *Whitelist
+		return r0, errors.New("hoststore: Fake.GetWhitelist is not implemented")
+	}
+	return f.GetWhitelistFunc(whitelistID)
+}
+
+// GetWhitelists calls GetWhitelistsFunc.
+func (f *Fake) GetWhitelists(opts ...filters.Option) (*response.ResultSet[Whitelist], error) {
+	if f.GetWhitelistsFunc == nil {
+		var r0 *response.ResultSet[Whitelist]
+		return r0, errors.New("hoststore: Fake.GetWhitelists is not implemented")
+	}
+	return f.GetWhitelistsFunc(opts...)
+}
+
+// ResolveHost calls ResolveHostFunc.
+func (f *Fake) ResolveHost(resolve HostResolve) (*Host, error) {
+	if f.ResolveHostFunc == nil {
+		var r0 *Host
+		return r0, errors.New("hoststore: Fake.ResolveHost is not implemented")
+	}
+	return f.ResolveHostFunc(resolve)
+}
+
+// SearchHosts calls SearchHostsFunc.
+func (f *Fake) SearchHosts(search *HostSearch, opts ...filters.Option) (*response.ResultSet[Host], error) {
+	if f.SearchHostsFunc == nil {
+		var r0 *response.ResultSet[Host]
+		return r0, errors.New("hoststore: Fake.SearchHosts is not implemented")
+	}
+	return f.SearchHostsFunc(search, opts...)
+}
+
+// SearchWhitelists calls SearchWhitelistsFunc.
+func (f *Fake) SearchWhitelists(search WhitelistSearch, opts ...filters.Option) (*response.ResultSet[Whitelist], error) {
+	if f.SearchWhitelistsFunc == nil {
+		var r0 *response.ResultSet[Whitelist]
+		return r0, errors.New("hoststore: Fake.SearchWhitelists is not implemented")
+	}
+	return f.SearchWhitelistsFunc(search, opts...)
+}
+
+// Status calls StatusFunc.
+func (f *Fake) Status() (*response.ServiceStatus, error) {
+	if f.StatusFunc == nil {
+		var r0 *response.ServiceStatus
+		return r0, errors.New("hoststore: Fake.Status is not implemented")
+	}
+	return f.StatusFunc()
+}
+
+// UpdateDeployStatus calls UpdateDeployStatusFunc.
+func (f *Fake) UpdateDeployStatus(hostID string, deployable bool) error {
+	if f.UpdateDeployStatusFunc == nil {
+		return errors.New("hoststore: Fake.UpdateDeployStatus is not implemented")
+	}
+	return f.UpdateDeployStatusFunc(hostID, deployable)
+}
+
+// UpdateHost calls UpdateHostFunc.
+func (f *Fake) UpdateHost(hostID string, host *Host) error {
+	if f.UpdateHostFunc == nil {
+		return errors.New("hoststore: Fake.UpdateHost is not implemented")
+	}
+	return f.UpdateHostFunc(hostID, host)
+}
+
+// UpdateHostStatus calls UpdateHostStatusFunc.
+func (f *Fake) UpdateHostStatus(hostID string, disabled bool) error {
+	if f.UpdateHostStatusFunc == nil {
+		return errors.New("hoststore: Fake.UpdateHostStatus is not implemented")
+	}
+	return f.UpdateHostStatusFunc(hostID, disabled)
+}
+
+// UpdateWhitelist calls UpdateWhitelistFunc.
+func (f *Fake) UpdateWhitelist(whitelistID string, whitelist Whitelist) error {
+	if f.UpdateWhitelistFunc == nil {
+		return errors.New("hoststore: Fake.UpdateWhitelist is not implemented")
+	}
+	return f.UpdateWhitelistFunc(whitelistID, whitelist)
+}
@@ -0,0 +1,42 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package hoststore
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Validate checks required fields of host, its services and principals.
+// Enumerated values, such as host type and disabled status, are left for the server to check.
+func (h *Host) Validate() error {
+	for _, addr := range h.Addresses {
+		if strings.TrimSpace(addr) == "" {
+			return fmt.Errorf("invalid host: empty address")
+		}
+	}
+
+	for _, service := range h.Services {
+		if service.Service == "" {
+			return fmt.Errorf("invalid host: service type is required")
+		}
+		if service.Address == "" {
+			return fmt.Errorf("invalid host: %s service address is required", service.Service)
+		}
+		if service.Port < 0 || service.Port > 65535 {
+			return fmt.Errorf("invalid host: %s service port %d is out of range", service.Service, service.Port)
+		}
+	}
+
+	for _, principal := range h.Principals {
+		if principal.Principal == "" {
+			return fmt.Errorf("invalid host: principal name is required")
+		}
+	}
+
+	return nil
+}
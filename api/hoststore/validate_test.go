@@ -0,0 +1,61 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package hoststore
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestHostValidate(t *testing.T) {
+	valid := Host{
+		HostType:  HostTypeBastion,
+		Addresses: []string{"10.0.0.1"},
+		Services:  []HostService{{Service: "SSH", Address: "10.0.0.1", Port: 22}},
+	}
+	if err := valid.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, mutate := range map[string]func(*Host){
+		"address": func(h *Host) { h.Addresses = []string{" "} },
+		"port":    func(h *Host) { h.Services = []HostService{{Service: "SSH", Address: "a", Port: 70000}} },
+	} {
+		host := valid
+		mutate(&host)
+		if err := host.Validate(); err == nil {
+			t.Errorf("invalid %s is accepted", name)
+		}
+	}
+}
+
+func TestHostValidateServerHost(t *testing.T) {
+	data := []byte(`{
+		"id": "1",
+		"common_name": "web",
+		"host_type": "UNKNOWN_TYPE",
+		"disabled": "NOT_DISABLED",
+		"addresses": ["10.0.0.1"],
+		"services": [{
+			"service": "SSH",
+			"address": "10.0.0.1",
+			"port": 22,
+			"status": "OK",
+			"status_updated": "2026-01-02T03:04:05Z"
+		}],
+		"principals": [{"principal": "root"}],
+		"created": "2026-01-01T00:00:00Z"
+	}`)
+
+	var host Host
+	if err := json.Unmarshal(data, &host); err != nil {
+		t.Fatal(err)
+	}
+	if err := host.Validate(); err != nil {
+		t.Fatalf("server host is rejected: %v", err)
+	}
+}
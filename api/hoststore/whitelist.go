@@ -0,0 +1,462 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package hoststore
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Statuses of whitelist patterns evaluated locally.
+const (
+	PatternOK      = "OK"
+	PatternInvalid = "INVALID"
+	PatternUnused  = "UNUSED"
+)
+
+// tPattern is compiled whitelist pattern
+type tPattern struct {
+	source string
+	re     *regexp.Regexp
+	err    error
+}
+
+/*
+WhitelistEvaluator evaluates commands against whitelist locally, without
+calling EvaluateWhitelist of host store. Glob patterns match the whole
+command, * matches any characters, ? matches a single character and
+[...] matches a character class. Regex patterns are RE2 expressions
+matching the whole command.
+
+Commands are parsed with the syntax of restricted shell variant. Command
+lines are split to simple commands at ;, &, |, &&, || and newline outside
+quotes, commands of subshells, command substitutions $(...) and `...` and
+bash process substitutions <(...) and >(...) are checked as well. The
+command is allowed if every command matches some pattern. Redirections,
+e.g. > file, and malformed commands are denied since patterns cannot
+restrict them. Evaluator is safe for concurrent use.
+
+	evaluator, err := hoststore.NewWhitelistEvaluator(&whitelist, hoststore.RShellBash)
+	if err != nil {
+		return err
+	}
+
+	result := evaluator.Evaluate("ls -la /tmp", "rm -rf /")
+*/
+type WhitelistEvaluator struct {
+	patterns []*tPattern
+	variant  RShellVariant
+}
+
+// NewWhitelistEvaluator compiles patterns of whitelist for the restricted
+// shell variant, empty variant is bash. Invalid patterns are reported by
+// pattern results of the evaluation.
+func NewWhitelistEvaluator(whitelist *Whitelist, variant RShellVariant) (*WhitelistEvaluator, error) {
+	compile := compileGlob
+	switch whitelist.Type {
+	case WhitelistGlob, "":
+	case WhitelistRegex:
+		compile = compileRegex
+	default:
+		return nil, fmt.Errorf("unknown whitelist type %q", whitelist.Type)
+	}
+
+	switch variant {
+	case "":
+		variant = RShellBash
+	case RShellBash, RShellPosix:
+	default:
+		return nil, fmt.Errorf("unknown restricted shell variant %q", variant)
+	}
+
+	evaluator := &WhitelistEvaluator{variant: variant}
+	for _, source := range whitelist.WhiteListPatterns {
+		re, err := compile(strings.TrimSpace(source))
+		evaluator.patterns = append(evaluator.patterns, &tPattern{source: source, re: re, err: err})
+	}
+
+	return evaluator, nil
+}
+
+// EvaluateWhitelistLocally evaluates commands of request locally, the
+// response has the same structure as response of EvaluateWhitelist.
+func EvaluateWhitelistLocally(evaluate *WhitelistEvaluate) (*WhitelistEvaluateResponse, error) {
+	evaluator, err := NewWhitelistEvaluator(&evaluate.WhiteList, evaluate.RShellVariant)
+	if err != nil {
+		return nil, err
+	}
+
+	return evaluator.Evaluate(evaluate.Commands...), nil
+}
+
+// Evaluate evaluates commands against whitelist patterns.
+func (e *WhitelistEvaluator) Evaluate(commands ...string) *WhitelistEvaluateResponse {
+	used := make([]bool, len(e.patterns))
+
+	result := &WhitelistEvaluateResponse{
+		WhiteListPatternResults: make([]WhitelistPatternResult, 0, len(e.patterns)),
+		CommandResults:          make([]CommandResult, 0, len(commands)),
+	}
+
+	for _, command := range commands {
+		result.CommandResults = append(result.CommandResults, CommandResult{
+			Command: command,
+			Allowed: e.allowed(command, used),
+		})
+	}
+
+	for i, p := range e.patterns {
+		status := []string{PatternOK}
+		switch {
+		case p.err != nil:
+			status = []string{PatternInvalid, p.err.Error()}
+		case !used[i]:
+			status = []string{PatternUnused}
+		}
+
+		result.WhiteListPatternResults = append(result.WhiteListPatternResults, WhitelistPatternResult{
+			WhiteListPattern: p.source,
+			Status:           status,
+		})
+	}
+
+	return result
+}
+
+// allowed checks if every command of command line matches a pattern, used
+// patterns are marked
+func (e *WhitelistEvaluator) allowed(command string, used []bool) bool {
+	commands, err := parseCommand(command, e.variant)
+	if err != nil || len(commands) == 0 {
+		return false
+	}
+
+	for _, cmd := range commands {
+		matched := false
+		for i, p := range e.patterns {
+			if p.re != nil && p.re.MatchString(cmd) {
+				used[i] = true
+				matched = true
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parseCommand parses command line to commands, including commands of
+// subshells and substitutions
+func parseCommand(command string, variant RShellVariant) ([]string, error) {
+	p := &tShellParser{src: []rune(command), bash: variant != RShellPosix}
+	if err := p.parse(0); err != nil {
+		return nil, err
+	}
+	return p.commands, nil
+}
+
+// tShellParser splits shell command line to commands
+type tShellParser struct {
+	src      []rune
+	pos      int
+	bash     bool
+	commands []string
+}
+
+// parse parses commands until the end rune, zero end parses until end of
+// input. Text of command substitutions remains in the enclosing command.
+func (p *tShellParser) parse(end rune) error {
+	var current strings.Builder
+
+	flush := func() {
+		if cmd := strings.TrimSpace(current.String()); cmd != "" {
+			p.commands = append(p.commands, cmd)
+		}
+		current.Reset()
+	}
+
+	for p.pos < len(p.src) {
+		r := p.src[p.pos]
+		next := p.peek(1)
+
+		switch {
+		case end != 0 && r == end:
+			p.pos++
+			flush()
+			return nil
+
+		case r == '\\':
+			current.WriteString(string(p.src[p.pos:min(p.pos+2, len(p.src))]))
+			p.pos += 2
+
+		case r == '\'':
+			start := p.pos
+			if err := p.skipQuote('\'', false); err != nil {
+				return err
+			}
+			current.WriteString(string(p.src[start:p.pos]))
+
+		case r == '"':
+			start := p.pos
+			if err := p.doubleQuote(); err != nil {
+				return err
+			}
+			current.WriteString(string(p.src[start:p.pos]))
+
+		case r == '$' && next == '\'' && p.bash:
+			start := p.pos
+			p.pos++
+			if err := p.skipQuote('\'', true); err != nil {
+				return err
+			}
+			current.WriteString(string(p.src[start:p.pos]))
+
+		case r == '$' && next == '(', r == '`':
+			start := p.pos
+			if err := p.substitution(); err != nil {
+				return err
+			}
+			current.WriteString(string(p.src[start:p.pos]))
+
+		case (r == '<' || r == '>') && next == '(':
+			if !p.bash {
+				return fmt.Errorf("process substitution is not supported by %s", RShellPosix)
+			}
+			start := p.pos
+			p.pos++
+			if err := p.substitution(); err != nil {
+				return err
+			}
+			current.WriteString(string(p.src[start:p.pos]))
+
+		case r == '<' || r == '>':
+			return fmt.Errorf("redirection is not allowed")
+
+		case r == '(':
+			flush()
+			p.pos++
+			if err := p.parse(')'); err != nil {
+				return err
+			}
+
+		case r == ')':
+			return fmt.Errorf("unbalanced parenthesis")
+
+		case r == ';' || r == '&' || r == '|' || r == '\n':
+			flush()
+			p.pos++
+
+		default:
+			current.WriteRune(r)
+			p.pos++
+		}
+	}
+
+	if end != 0 {
+		return fmt.Errorf("unterminated %q", end)
+	}
+	flush()
+	return nil
+}
+
+// substitution parses command substitution starting at $( or ` and process
+// substitution starting at (, commands are added to parsed commands
+func (p *tShellParser) substitution() error {
+	end := ')'
+	switch p.src[p.pos] {
+	case '`':
+		end = '`'
+	case '$':
+		p.pos++
+	}
+	p.pos++
+
+	return p.parse(end)
+}
+
+// doubleQuote skips double quoted string, command substitutions within the
+// string are parsed
+func (p *tShellParser) doubleQuote() error {
+	p.pos++
+	for p.pos < len(p.src) {
+		switch r := p.src[p.pos]; {
+		case r == '\\':
+			p.pos += 2
+		case r == '"':
+			p.pos++
+			return nil
+		case r == '$' && p.peek(1) == '(', r == '`':
+			if err := p.substitution(); err != nil {
+				return err
+			}
+		default:
+			p.pos++
+		}
+	}
+	return fmt.Errorf("unterminated quote")
+}
+
+// skipQuote skips quoted string, backslash escapes quote if escapes is set
+func (p *tShellParser) skipQuote(quote rune, escapes bool) error {
+	p.pos++
+	for p.pos < len(p.src) {
+		switch p.src[p.pos] {
+		case '\\':
+			if escapes {
+				p.pos++
+			}
+		case quote:
+			p.pos++
+			return nil
+		}
+		p.pos++
+	}
+	return fmt.Errorf("unterminated quote")
+}
+
+// peek returns rune at offset from current position, zero at end of input
+func (p *tShellParser) peek(offset int) rune {
+	if p.pos+offset < len(p.src) {
+		return p.src[p.pos+offset]
+	}
+	return 0
+}
+
+// compileGlob compiles glob pattern to regular expression matching the
+// whole command
+func compileGlob(glob string) (*regexp.Regexp, error) {
+	if glob == "" {
+		return nil, fmt.Errorf("empty pattern")
+	}
+
+	var b strings.Builder
+	b.WriteString(`^`)
+
+	runes := []rune(glob)
+	for i := 0; i < len(runes); i++ {
+		switch r := runes[i]; r {
+		case '*':
+			b.WriteString(`.*`)
+		case '?':
+			b.WriteString(`.`)
+		case '\\':
+			if i+1 < len(runes) {
+				i++
+				b.WriteString(regexp.QuoteMeta(string(runes[i])))
+			} else {
+				b.WriteString(`\\`)
+			}
+		case '[':
+			class, end, err := globClass(runes, i)
+			if err != nil {
+				return nil, fmt.Errorf("%w in %q", err, glob)
+			}
+			b.WriteString(class)
+			i = end
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+
+	b.WriteString(`$`)
+	return regexp.Compile(b.String())
+}
+
+// globClass translates character class of glob starting at index i to
+// regular expression, it returns index of the closing bracket. POSIX
+// classes, e.g. [:alpha:], are kept.
+func globClass(runes []rune, i int) (string, int, error) {
+	var b strings.Builder
+	b.WriteString("[")
+
+	end := i + 1
+	if end < len(runes) && (runes[end] == '!' || runes[end] == '^') {
+		b.WriteString("^")
+		end++
+	}
+	if end < len(runes) && runes[end] == ']' {
+		b.WriteString(`\]`)
+		end++
+	}
+
+	for ; end < len(runes); end++ {
+		switch r := runes[end]; {
+		case r == ']':
+			b.WriteString("]")
+			return b.String(), end, nil
+		case r == '[' && end+1 < len(runes) && runes[end+1] == ':':
+			closing := strings.Index(string(runes[end+2:]), ":]")
+			if closing < 0 {
+				return "", 0, fmt.Errorf("unterminated character class")
+			}
+			name := string(runes[end+2:])[:closing]
+			b.WriteString("[:" + name + ":]")
+			end += 2 + len([]rune(name)) + 1
+		case r == '\\' || r == '[':
+			b.WriteString(`\` + string(r))
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return "", 0, fmt.Errorf("unterminated character class")
+}
+
+// compileRegex compiles regular expression matching the whole command
+func compileRegex(expr string) (*regexp.Regexp, error) {
+	if expr == "" {
+		return nil, fmt.Errorf("empty pattern")
+	}
+	return regexp.Compile(`^(?:` + expr + `)$`)
+}
+
+// WhitelistMismatch command evaluated differently locally and by host store.
+type WhitelistMismatch struct {
+	// Index is the position of command in the evaluated commands.
+	Index   int
+	Command string
+	Local   bool
+	Server  bool
+}
+
+// CrossCheckWhitelist evaluates commands locally and by host store, it
+// returns commands with different results. Results are compared by the
+// position of command, so duplicate commands are checked separately.
+func CrossCheckWhitelist(store Service, evaluate *WhitelistEvaluate) ([]WhitelistMismatch, error) {
+	local, err := EvaluateWhitelistLocally(evaluate)
+	if err != nil {
+		return nil, err
+	}
+
+	server, err := store.EvaluateWhitelist(evaluate)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(server.CommandResults) != len(local.CommandResults) {
+		return nil, fmt.Errorf("host store evaluated %d commands, expected %d",
+			len(server.CommandResults), len(local.CommandResults))
+	}
+
+	var mismatches []WhitelistMismatch
+	for i, result := range local.CommandResults {
+		remote := server.CommandResults[i]
+		if remote.Command != result.Command || remote.Allowed != result.Allowed {
+			mismatches = append(mismatches, WhitelistMismatch{
+				Index:   i,
+				Command: result.Command,
+				Local:   result.Allowed,
+				Server:  remote.Allowed,
+			})
+		}
+	}
+
+	return mismatches, nil
+}
@@ -0,0 +1,176 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package hoststore
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestWhitelistEvaluatorGlob(t *testing.T) {
+	evaluator, err := NewWhitelistEvaluator(&Whitelist{
+		Type:              WhitelistGlob,
+		WhiteListPatterns: []string{"ls *", "cat /var/log/[a-z]*.log", "whoami", "rm -rf /tmp/?", "[z", "id [[:alpha:]_]*", "[[:nope:]]"},
+	}, RShellBash)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := evaluator.Evaluate(
+		"ls -la /tmp",
+		"cat /var/log/syslog.log",
+		"cat /var/log/1.log",
+		"whoami; ls /",
+		"whoami && rm -rf /",
+		"ls 'a;b'",
+		"",
+		"id user_1",
+		"id 1user",
+	)
+
+	expect := []bool{true, true, false, true, false, true, false, true, false}
+	for i, r := range result.CommandResults {
+		if r.Allowed != expect[i] {
+			t.Errorf("command %q allowed %v, expected %v", r.Command, r.Allowed, expect[i])
+		}
+	}
+
+	status := map[string]string{}
+	for _, r := range result.WhiteListPatternResults {
+		status[r.WhiteListPattern] = r.Status[0]
+	}
+	if status["ls *"] != PatternOK || status["rm -rf /tmp/?"] != PatternUnused || status["[z"] != PatternInvalid ||
+		status["id [[:alpha:]_]*"] != PatternOK || status["[[:nope:]]"] != PatternInvalid {
+		t.Errorf("unexpected pattern status %v", status)
+	}
+}
+
+func TestWhitelistEvaluatorRegex(t *testing.T) {
+	result, err := EvaluateWhitelistLocally(&WhitelistEvaluate{
+		WhiteList: Whitelist{
+			Type:              WhitelistRegex,
+			WhiteListPatterns: []string{`systemctl (status|restart) nginx`, `(`},
+		},
+		Commands: []string{"systemctl restart nginx", "systemctl stop nginx", "sudo systemctl status nginx"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := []bool{true, false, false}
+	for i, r := range result.CommandResults {
+		if r.Allowed != expect[i] {
+			t.Errorf("command %q allowed %v, expected %v", r.Command, r.Allowed, expect[i])
+		}
+	}
+
+	if r := result.WhiteListPatternResults[1]; r.Status[0] != PatternInvalid {
+		t.Errorf("pattern %q status %v, expected invalid", r.WhiteListPattern, r.Status)
+	}
+
+	if _, err := NewWhitelistEvaluator(&Whitelist{Type: "unknown"}, ""); err == nil {
+		t.Error("unknown whitelist type accepted")
+	}
+	if _, err := NewWhitelistEvaluator(&Whitelist{}, "csh"); err == nil {
+		t.Error("unknown shell variant accepted")
+	}
+}
+
+func TestWhitelistEvaluatorSubstitution(t *testing.T) {
+	whitelist := &Whitelist{WhiteListPatterns: []string{"ls *", "echo *", "diff *", "date"}}
+
+	for variant, commands := range map[RShellVariant]map[string]bool{
+		RShellBash: {
+			"ls $(rm -rf /)":              false,
+			"ls `reboot`":                 false,
+			"echo hi > /etc/passwd":       false,
+			"echo hi >> /etc/passwd":      false,
+			"ls < /etc/shadow":            false,
+			"diff <(rm -rf /) /tmp/a":     false,
+			"echo \"$(reboot)\"":          false,
+			"(reboot)":                    false,
+			"ls $(ls":                     false,
+			"echo $(date)":                true,
+			"echo \"`date`\" '$(reboot)'": true,
+			"diff <(ls /a) <(ls /b)":      true,
+			"echo '>' \\> \"<\"":          true,
+			"echo $'a\\'b' ; date":        true,
+		},
+		RShellPosix: {
+			"diff <(ls /a) <(ls /b)": false,
+			"echo $(date)":           true,
+		},
+	} {
+		evaluator, err := NewWhitelistEvaluator(whitelist, variant)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for command, expect := range commands {
+			if r := evaluator.Evaluate(command).CommandResults[0]; r.Allowed != expect {
+				t.Errorf("%s: command %q allowed %v, expected %v", variant, command, r.Allowed, expect)
+			}
+		}
+	}
+}
+
+func TestWhitelistEvaluatorConcurrent(t *testing.T) {
+	evaluator, err := NewWhitelistEvaluator(&Whitelist{WhiteListPatterns: []string{"ls *", "date"}}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for _, command := range []string{"ls /", "date"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				result := evaluator.Evaluate(command)
+				unused := 0
+				for _, r := range result.WhiteListPatternResults {
+					if r.Status[0] == PatternUnused {
+						unused++
+					}
+				}
+				if unused != 1 {
+					t.Errorf("usage of %q leaks between evaluations", command)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestCrossCheckWhitelist(t *testing.T) {
+	store := &Fake{
+		EvaluateWhitelistFunc: func(evaluate *WhitelistEvaluate) (*WhitelistEvaluateResponse, error) {
+			return &WhitelistEvaluateResponse{
+				CommandResults: []CommandResult{
+					{Command: "ls /", Allowed: true},
+					{Command: "ls / | grep x", Allowed: true},
+					{Command: "ls /", Allowed: false},
+				},
+			}, nil
+		},
+	}
+
+	mismatches, err := CrossCheckWhitelist(store, &WhitelistEvaluate{
+		WhiteList: Whitelist{Type: WhitelistGlob, WhiteListPatterns: []string{"ls *"}},
+		Commands:  []string{"ls /", "ls / | grep x", "ls /"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(mismatches) != 2 ||
+		mismatches[0].Index != 1 || mismatches[0].Command != "ls / | grep x" || mismatches[0].Local || !mismatches[0].Server ||
+		mismatches[1].Index != 2 || mismatches[1].Command != "ls /" || !mismatches[1].Local || mismatches[1].Server {
+		t.Errorf("unexpected mismatches %+v", mismatches)
+	}
+}
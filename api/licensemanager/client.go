@@ -6,6 +6,8 @@
 
 package licensemanager
 
+//go:generate go run github.com/SSHcom/privx-sdk-go/v2/internal/mockgen
+
 import (
 	"github.com/SSHcom/privx-sdk-go/v2/api/response"
 	"github.com/SSHcom/privx-sdk-go/v2/restapi"
@@ -35,8 +37,8 @@ func (c *LicenseManager) Status() (*response.ServiceStatus, error) {
 
 // MARK: License
 // GetLicense get license.
-func (c *LicenseManager) GetLicense() (map[string]interface{}, error) {
-	license := map[string]interface{}{}
+func (c *LicenseManager) GetLicense() (*License, error) {
+	license := &License{}
 
 	_, err := c.api.
 		URL("/license-manager/api/v1/license").
@@ -55,8 +57,8 @@ func (c *LicenseManager) SetLicense(licenseCode string) error {
 }
 
 // RefreshLicense refresh license info.
-func (c *LicenseManager) RefreshLicense() (map[string]interface{}, error) {
-	license := map[string]interface{}{}
+func (c *LicenseManager) RefreshLicense() (*License, error) {
+	license := &License{}
 
 	_, err := c.api.
 		URL("/license-manager/api/v1/license/refresh").
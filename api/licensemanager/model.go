@@ -7,6 +7,7 @@
 package licensemanager
 
 import (
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
 	"github.com/SSHcom/privx-sdk-go/v2/api/rolestore"
 )
 
@@ -21,3 +22,29 @@ type RegistrationStatus struct {
 	UsedSources []rolestore.Source `json:"used_sources"`
 	ProductId   string             `json:"product_id"`
 }
+
+// License license response definition.
+type License struct {
+	LicenseCode string             `json:"license_code,omitempty"`
+	Status      string             `json:"status,omitempty"`
+	Product     string             `json:"product,omitempty"`
+	Variant     string             `json:"variant,omitempty"`
+	Owner       string             `json:"owner,omitempty"`
+	MaxUsers    int                `json:"max_users,omitempty"`
+	MaxHosts    int                `json:"max_hosts,omitempty"`
+	ExpiresAt   response.Timestamp `json:"expires_at,omitzero"`
+
+	// Extra holds fields unknown to the model.
+	Extra response.Extra `json:"-"`
+}
+
+func (l *License) UnmarshalJSON(data []byte) (err error) {
+	type license License
+	l.Extra, err = response.DecodeExtra(data, (*license)(l))
+	return
+}
+
+func (l License) MarshalJSON() ([]byte, error) {
+	type license License
+	return response.EncodeExtra(license(l), l.Extra)
+}
@@ -0,0 +1,139 @@
+// Code generated by mockgen from LicenseManager method set; DO NOT EDIT.
+
+package licensemanager
+
+import (
+	"errors"
+
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
+)
+
+// Service is the interface of LicenseManager client, it allows substitution of
+// the client in tests, see Fake.
+type Service interface {
+	// DeactivateLicense deactivate license.
+	DeactivateLicense() error
+	// GetLicense get license.
+	GetLicense() (*License, error)
+	// GetLicenseJSSnippet get PrivX license javascript snippet.
+	GetLicenseJSSnippet() (string, error)
+	// Get PrivX registration status to mobile gateway.
+	GetMobileGwRegistration() (*RegistrationStatus, error)
+	// RefreshLicense refresh license info.
+	RefreshLicense() (*License, error)
+	// RegisterToMobileGw register PrivX instance to mobile gateway.
+	RegisterToMobileGw() error
+	// SetLicense set new license.
+	SetLicense(licenseCode string) error
+	// SetLicenseStatistics set settings for SSH license statistics.
+	SetLicenseStatistics(optin LicenseStatistics) error
+	// Status get license manager microservice status.
+	Status() (*response.ServiceStatus, error)
+	// UnregisterFromMobileGw unregister PrivX instance from mobile gateway.
+	UnregisterFromMobileGw() error
+}
+
+var (
+	_ Service = (*LicenseManager)(nil)
+	_ Service = (*Fake)(nil)
+)
+
+// Fake is a fake of LicenseManager client. Methods delegate to the corresponding
+// function fields, undefined functions return an error.
+type Fake struct {
+	DeactivateLicenseFunc       func() error
+	GetLicenseFunc              func() (*License, error)
+	GetLicenseJSSnippetFunc     func() (string, error)
+	GetMobileGwRegistrationFunc func() (*RegistrationStatus, error)
+	RefreshLicenseFunc          func() (*License, error)
+	RegisterToMobileGwFunc      func() error
+	SetLicenseFunc              func(string) error
+	SetLicenseStatisticsFunc    func(LicenseStatistics) error
+	StatusFunc                  func() (*response.ServiceStatus, error)
+	UnregisterFromMobileGwFunc  func() error
+}
+
+// DeactivateLicense calls DeactivateLicenseFunc.
+func (f *Fake) DeactivateLicense() error {
+	if f.DeactivateLicenseFunc == nil {
+		return errors.New("licensemanager: Fake.DeactivateLicense is not implemented")
+	}
+	return f.DeactivateLicenseFunc()
+}
+
+// GetLicense calls GetLicenseFunc.
+func (f *Fake) GetLicense() (*License, error) {
+	if f.GetLicenseFunc == nil {
+		var r0 *License
+		return r0, errors.New("licensemanager: Fake.GetLicense is not implemented")
+	}
+	return f.GetLicenseFunc()
+}
+
+// GetLicenseJSSnippet calls GetLicenseJSSnippetFunc.
+func (f *Fake) GetLicenseJSSnippet() (string, error) {
+	if f.GetLicenseJSSnippetFunc == nil {
+		var r0 string
+		return r0, errors.New("licensemanager: Fake.GetLicenseJSSnippet is not implemented")
+	}
+	return f.GetLicenseJSSnippetFunc()
+}
+
+// GetMobileGwRegistration calls GetMobileGwRegistrationFunc.
+func (f *Fake) GetMobileGwRegistration() (*RegistrationStatus, error) {
+	if f.GetMobileGwRegistrationFunc == nil {
+		var r0 *RegistrationStatus
+		return r0, errors.New("licensemanager: Fake.GetMobileGwRegistration is not implemented")
+	}
+	return f.GetMobileGwRegistrationFunc()
+}
+
+// RefreshLicense calls RefreshLicenseFunc.
+func (f *Fake) RefreshLicense() (*License, error) {
+	if f.RefreshLicenseFunc == nil {
+		var r0 *License
+		return r0, errors.New("licensemanager: Fake.RefreshLicense is not implemented")
+	}
+	return f.RefreshLicenseFunc()
+}
+
+// RegisterToMobileGw calls RegisterToMobileGwFunc.
+func (f *Fake) RegisterToMobileGw() error {
+	if f.RegisterToMobileGwFunc == nil {
+		return errors.New("licensemanager: Fake.RegisterToMobileGw is not implemented")
+	}
+	return f.RegisterToMobileGwFunc()
+}
+
+// SetLicense calls SetLicenseFunc.
+func (f *Fake) SetLicense(licenseCode string) error {
+	if f.SetLicenseFunc == nil {
+		return errors.New("licensemanager: Fake.SetLicense is not implemented")
+	}
+	return f.SetLicenseFunc(licenseCode)
+}
+
+// SetLicenseStatistics calls SetLicenseStatisticsFunc.
+func (f *Fake) SetLicenseStatistics(optin LicenseStatistics) error {
+	if f.SetLicenseStatisticsFunc == nil {
+		return errors.New("licensemanager: Fake.SetLicenseStatistics is not implemented")
+	}
+	return f.SetLicenseStatisticsFunc(optin)
+}
+
+// Status calls StatusFunc.
+func (f *Fake) Status() (*response.ServiceStatus, error) {
+	if f.StatusFunc == nil {
+		var r0 *response.ServiceStatus
+		return r0, errors.New("licensemanager: Fake.Status is not implemented")
+	}
+	return f.StatusFunc()
+}
+
+// UnregisterFromMobileGw calls UnregisterFromMobileGwFunc.
+func (f *Fake) UnregisterFromMobileGw() error {
+	if f.UnregisterFromMobileGwFunc == nil {
+		return errors.New("licensemanager: Fake.UnregisterFromMobileGw is not implemented")
+	}
+	return f.UnregisterFromMobileGwFunc()
+}
@@ -6,8 +6,9 @@
 
 package monitor
 
+//go:generate go run github.com/SSHcom/privx-sdk-go/v2/internal/mockgen
+
 import (
-	"encoding/json"
 	"net/url"
 
 	"github.com/SSHcom/privx-sdk-go/v2/api/filters"
@@ -50,8 +51,8 @@ func (c *Monitor) SearchAuditEvents(search *AuditEventSearch, opts ...filters.Op
 		filters.FuzzyCount(true),
 	}, opts...)
 
-	for _, opt := range options {
-		opt(&params)
+	if err := filters.Apply(&params, options...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -74,8 +75,8 @@ func (c *Monitor) GetAuditEvents(opts ...filters.Option) (*response.ResultSet[Au
 		filters.FuzzyCount(true),
 	}, opts...)
 
-	for _, opt := range options {
-		opt(&params)
+	if err := filters.Apply(&params, options...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -99,8 +100,8 @@ func (c *Monitor) GetAuditEventCodes() (*AuditEventCodes, error) {
 
 // MARK: Components
 // GetComponentsStatus get components status.
-func (c *Monitor) GetComponentsStatus() (*json.RawMessage, error) {
-	status := &json.RawMessage{}
+func (c *Monitor) GetComponentsStatus() (ComponentsStatus, error) {
+	status := ComponentsStatus{}
 
 	_, err := c.api.
 		URL("/monitor-service/api/v1/components").
@@ -110,8 +111,8 @@ func (c *Monitor) GetComponentsStatus() (*json.RawMessage, error) {
 }
 
 // GetComponentStatus get component status by hostname.
-func (c *Monitor) GetComponentStatus(hostname string) (*json.RawMessage, error) {
-	status := &json.RawMessage{}
+func (c *Monitor) GetComponentStatus(hostname string) (*ComponentStatus, error) {
+	status := &ComponentStatus{}
 
 	_, err := c.api.
 		URL("/monitor-service/api/v1/components/%s", hostname).
@@ -122,8 +123,8 @@ func (c *Monitor) GetComponentStatus(hostname string) (*json.RawMessage, error)
 
 // MARK: Instance
 // GetInstanceStatus get PrivX instance status.
-func (c *Monitor) GetInstanceStatus() (*json.RawMessage, error) {
-	status := &json.RawMessage{}
+func (c *Monitor) GetInstanceStatus() (*InstanceStatus, error) {
+	status := &InstanceStatus{}
 
 	_, err := c.api.
 		URL("/monitor-service/api/v1/instance/status").
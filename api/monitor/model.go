@@ -6,17 +6,24 @@
 
 package monitor
 
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
+)
+
 // AuditEventSearch audit event search request definitions.
 type AuditEventSearch struct {
-	Keywords      string `json:"keywords"`
-	UserID        string `json:"user_id"`
-	ConnectionID  string `json:"connection_id"`
-	HostID        string `json:"host_id"`
-	SourceID      string `json:"source_id"`
-	SessionID     string `json:"session_id"`
-	AccessGroupID string `json:"access_group_id"`
-	StartTime     string `json:"start_time"`
-	EndTime       string `json:"end_time"`
+	Keywords      string             `json:"keywords"`
+	UserID        string             `json:"user_id"`
+	ConnectionID  string             `json:"connection_id"`
+	HostID        string             `json:"host_id"`
+	SourceID      string             `json:"source_id"`
+	SessionID     string             `json:"session_id"`
+	AccessGroupID string             `json:"access_group_id"`
+	StartTime     response.Timestamp `json:"start_time"`
+	EndTime       response.Timestamp `json:"end_time"`
 }
 
 // AuditEventCodes audit event codes response definitions
@@ -31,15 +38,99 @@ type AuditEventInfo struct {
 
 // AuditEvent audit event definition.
 type AuditEvent struct {
-	ServiceID   string            `json:"service_id,omitempty"`
-	ServiceName string            `json:"service_name,omitempty"`
-	EventID     string            `json:"event_id,omitempty"`
-	EventName   string            `json:"event_name,omitempty"`
-	Created     string            `json:"created,omitempty"`
-	Message     map[string]string `json:"message,omitempty"`
+	ServiceID   string             `json:"service_id,omitempty"`
+	ServiceName string             `json:"service_name,omitempty"`
+	EventID     string             `json:"event_id,omitempty"`
+	EventName   string             `json:"event_name,omitempty"`
+	Created     response.Timestamp `json:"created,omitzero"`
+	Message     map[string]string  `json:"message,omitempty"`
 }
 
 // Clock server clock definition.
 type Clock struct {
 	TimeUTC string `json:"time_utc"`
 }
+
+// ComponentStatus status of PrivX component and its services.
+type ComponentStatus struct {
+	Hostname      string                            `json:"hostname"`
+	Version       string                            `json:"version,omitempty"`
+	Status        string                            `json:"status,omitempty"`
+	StatusMessage string                            `json:"status_message,omitempty"`
+	Services      map[string]response.ServiceStatus `json:"services,omitempty"`
+
+	// Extra holds fields unknown to the model.
+	Extra response.Extra `json:"-"`
+}
+
+func (s *ComponentStatus) UnmarshalJSON(data []byte) (err error) {
+	type status ComponentStatus
+	s.Extra, err = response.DecodeExtra(data, (*status)(s))
+	return
+}
+
+func (s ComponentStatus) MarshalJSON() ([]byte, error) {
+	type status ComponentStatus
+	return response.EncodeExtra(status(s), s.Extra)
+}
+
+// ComponentsStatus status of all PrivX components.
+type ComponentsStatus []ComponentStatus
+
+// UnmarshalJSON decodes list of components, result set of components or
+// components keyed by hostname.
+func (s *ComponentsStatus) UnmarshalJSON(data []byte) error {
+	var list []ComponentStatus
+	if err := json.Unmarshal(data, &list); err == nil {
+		*s = list
+		return nil
+	}
+
+	var set response.ResultSet[ComponentStatus]
+	if err := json.Unmarshal(data, &set); err == nil && set.Items != nil {
+		*s = set.Items
+		return nil
+	}
+
+	var byHost map[string]ComponentStatus
+	if err := json.Unmarshal(data, &byHost); err != nil {
+		return err
+	}
+
+	hosts := make([]string, 0, len(byHost))
+	for host := range byHost {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+
+	*s = make(ComponentsStatus, 0, len(hosts))
+	for _, host := range hosts {
+		component := byHost[host]
+		if component.Hostname == "" {
+			component.Hostname = host
+		}
+		*s = append(*s, component)
+	}
+	return nil
+}
+
+// InstanceStatus PrivX instance status definition.
+type InstanceStatus struct {
+	Status        string              `json:"status"`
+	StatusMessage string              `json:"status_message,omitempty"`
+	StatusDetails []response.KeyValue `json:"status_details,omitempty"`
+
+	// Extra holds fields unknown to the model.
+	Extra response.Extra `json:"-"`
+}
+
+func (s *InstanceStatus) UnmarshalJSON(data []byte) (err error) {
+	type status InstanceStatus
+	s.Extra, err = response.DecodeExtra(data, (*status)(s))
+	return
+}
+
+func (s InstanceStatus) MarshalJSON() ([]byte, error) {
+	type status InstanceStatus
+	return response.EncodeExtra(status(s), s.Extra)
+}
@@ -0,0 +1,31 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package monitor
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestComponentsStatus(t *testing.T) {
+	for _, raw := range []string{
+		`[{"hostname": "a", "status": "OK"}, {"hostname": "b"}]`,
+		`{"count": 2, "items": [{"hostname": "a", "status": "OK"}, {"hostname": "b"}]}`,
+		`{"b": {}, "a": {"status": "OK"}}`,
+	} {
+		var status ComponentsStatus
+		if err := json.Unmarshal([]byte(raw), &status); err != nil {
+			t.Fatalf("%s: %v", raw, err)
+		}
+
+		if len(status) != 2 ||
+			status[0].Hostname != "a" || status[0].Status != "OK" ||
+			status[1].Hostname != "b" {
+			t.Errorf("%s: unexpected status: %+v", raw, status)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+// Code generated by mockgen from Monitor method set; DO NOT EDIT.
+
+package monitor
+
+import (
+	"errors"
+
+	"github.com/SSHcom/privx-sdk-go/v2/api/filters"
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
+)
+
+// Service is the interface of Monitor client, it allows substitution of
+// the client in tests, see Fake.
+type Service interface {
+	// GetAuditEventCodes get audit event codes.
+	GetAuditEventCodes() (*AuditEventCodes, error)
+	// GetAuditEvents get audit events.
+	GetAuditEvents(opts ...filters.Option) (*response.ResultSet[AuditEvent], error)
+	// GetComponentStatus get component status by hostname.
+	GetComponentStatus(hostname string) (*ComponentStatus, error)
+	// GetComponentsStatus get components status.
+	GetComponentsStatus() (ComponentsStatus, error)
+	// GetInstanceStatus get PrivX instance status.
+	GetInstanceStatus() (*InstanceStatus, error)
+	// GetServerTime get current PrivX server time.
+	GetServerTime() (Clock, error)
+	// SearchAuditEvents search audit events.
+	SearchAuditEvents(search *AuditEventSearch, opts ...filters.Option) (*response.ResultSet[AuditEvent], error)
+	// Status get monitor service microservice status.
+	Status() (*response.ServiceStatus, error)
+	// TerminateInstances terminate PrivX instances.
+	TerminateInstances() error
+}
+
+var (
+	_ Service = (*Monitor)(nil)
+	_ Service = (*Fake)(nil)
+)
+
+// Fake is a fake of Monitor client. Methods delegate to the corresponding
+// function fields, undefined functions return an error.
+type Fake struct {
+	GetAuditEventCodesFunc  func() (*AuditEventCodes, error)
+	GetAuditEventsFunc      func(...filters.Option) (*response.ResultSet[AuditEvent], error)
+	GetComponentStatusFunc  func(string) (*ComponentStatus, error)
+	GetComponentsStatusFunc func() (ComponentsStatus, error)
+	GetInstanceStatusFunc   func() (*InstanceStatus, error)
+	GetServerTimeFunc       func() (Clock, error)
+	SearchAuditEventsFunc   func(*AuditEventSearch, ...filters.Option) (*response.ResultSet[AuditEvent], error)
+	StatusFunc              func() (*response.ServiceStatus, error)
+	TerminateInstancesFunc  func() error
+}
+
+// GetAuditEventCodes calls GetAuditEventCodesFunc.
+func (f *Fake) GetAuditEventCodes() (*AuditEventCodes, error) {
+	if f.GetAuditEventCodesFunc == nil {
+		var r0 *AuditEventCodes
+		return r0, errors.New("monitor: Fake.GetAuditEventCodes is not implemented")
+	}
+	return f.GetAuditEventCodesFunc()
+}
+
+// GetAuditEvents calls GetAuditEventsFunc.
+func (f *Fake) GetAuditEvents(opts ...filters.Option) (*response.ResultSet[AuditEvent], error) {
+	if f.GetAuditEventsFunc == nil {
+		var r0 *response.ResultSet[AuditEvent]
+		return r0, errors.New("monitor: Fake.GetAuditEvents is not implemented")
+	}
+	return f.GetAuditEventsFunc(opts...)
+}
+
+// GetComponentStatus calls GetComponentStatusFunc.
+func (f *Fake) GetComponentStatus(hostname string) (*ComponentStatus, error) {
+	if f.GetComponentStatusFunc == nil {
+		var r0 *ComponentStatus
+		return r0, errors.New("monitor: Fake.GetComponentStatus is not implemented")
+	}
+	return f.GetComponentStatusFunc(hostname)
+}
+
+// GetComponentsStatus calls GetComponentsStatusFunc.
+func (f *Fake) GetComponentsStatus() (ComponentsStatus, error) {
+	if f.GetComponentsStatusFunc == nil {
+		var r0 ComponentsStatus
+		return r0, errors.New("monitor: Fake.GetComponentsStatus is not implemented")
+	}
+	return f.GetComponentsStatusFunc()
+}
+
+// GetInstanceStatus calls GetInstanceStatusFunc.
+func (f *Fake) GetInstanceStatus() (*InstanceStatus, error) {
+	if f.GetInstanceStatusFunc == nil {
+		var r0 *InstanceStatus
+		return r0, errors.New("monitor: Fake.GetInstanceStatus is not implemented")
+	}
+	return f.GetInstanceStatusFunc()
+}
+
+// GetServerTime calls GetServerTimeFunc.
+func (f *Fake) GetServerTime() (Clock, error) {
+	if f.GetServerTimeFunc == nil {
+		var r0 Clock
+		return r0, errors.New("monitor: Fake.GetServerTime is not implemented")
+	}
+	return f.GetServerTimeFunc()
+}
+
+// SearchAuditEvents calls SearchAuditEventsFunc.
+func (f *Fake) SearchAuditEvents(search *AuditEventSearch, opts ...filters.Option) (*response.ResultSet[AuditEvent], error) {
+	if f.SearchAuditEventsFunc == nil {
+		var r0 *response.ResultSet[AuditEvent]
+		return r0, errors.New("monitor: Fake.SearchAuditEvents is not implemented")
+	}
+	return f.SearchAuditEventsFunc(search, opts...)
+}
+
+// Status calls StatusFunc.
+func (f *Fake) Status() (*response.ServiceStatus, error) {
+	if f.StatusFunc == nil {
+		var r0 *response.ServiceStatus
+		return r0, errors.New("monitor: Fake.Status is not implemented")
+	}
+	return f.StatusFunc()
+}
+
+// TerminateInstances calls TerminateInstancesFunc.
+func (f *Fake) TerminateInstances() error {
+	if f.TerminateInstancesFunc == nil {
+		return errors.New("monitor: Fake.TerminateInstances is not implemented")
+	}
+	return f.TerminateInstancesFunc()
+}
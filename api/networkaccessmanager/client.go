@@ -6,6 +6,8 @@
 
 package networkaccessmanager
 
+//go:generate go run github.com/SSHcom/privx-sdk-go/v2/internal/mockgen
+
 import (
 	"net/url"
 
@@ -42,8 +44,8 @@ func (c *NetworkAccessManager) GetNetworkTargets(opts ...filters.Option) (*respo
 	targets := &response.ResultSet[NetworkTarget]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -70,8 +72,8 @@ func (c *NetworkAccessManager) SearchNetworkTargets(search NetworkTargetSearch,
 	targets := &response.ResultSet[NetworkTarget]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -87,8 +89,8 @@ func (c *NetworkAccessManager) GetNetworkTargetTags(opts ...filters.Option) (*re
 	tags := &response.ResultSet[string]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -6,6 +6,8 @@
 
 package networkaccessmanager
 
+import "github.com/SSHcom/privx-sdk-go/v2/api/response"
+
 // NetworkTargetTagsParams network target tags query parameter definition.
 type NetworkTargetTagsParams struct {
 	Query int `url:"query,omitempty"`
@@ -18,22 +20,22 @@ type NetworkTargetDisable struct {
 
 // NetworkTarget network target definition.
 type NetworkTarget struct {
-	ID               string        `json:"id"`
-	Name             string        `json:"name"`
-	Dst              []Destination `json:"dst"`
-	SrcNAT           bool          `json:"src_nat,omitempty"`
-	StaticConfig     string        `json:"static_config,omitempty"`
-	Roles            []RoleHandle  `json:"roles"`
-	Tags             []string      `json:"tags"`
-	Comment          string        `json:"comment,omitempty"`
-	UserInstructions string        `json:"user_instructions,omitempty"`
-	IntegrationType  string        `json:"integration_type"`
-	ExclusiveAccess  bool          `json:"exclusive_access,omitempty"`
-	Disabled         string        `json:"disabled,omitempty"`
-	Created          string        `json:"created"`
-	Author           string        `json:"author"`
-	Updated          string        `json:"updated"`
-	UpdatedBy        string        `json:"updated_by"`
+	ID               string             `json:"id"`
+	Name             string             `json:"name"`
+	Dst              []Destination      `json:"dst"`
+	SrcNAT           bool               `json:"src_nat,omitempty"`
+	StaticConfig     string             `json:"static_config,omitempty"`
+	Roles            []RoleHandle       `json:"roles"`
+	Tags             []string           `json:"tags"`
+	Comment          string             `json:"comment,omitempty"`
+	UserInstructions string             `json:"user_instructions,omitempty"`
+	IntegrationType  string             `json:"integration_type"`
+	ExclusiveAccess  bool               `json:"exclusive_access,omitempty"`
+	Disabled         string             `json:"disabled,omitempty"`
+	Created          response.Timestamp `json:"created"`
+	Author           string             `json:"author"`
+	Updated          response.Timestamp `json:"updated"`
+	UpdatedBy        string             `json:"updated_by"`
 }
 
 // RoleHandle is a handle to network target role definition.
@@ -62,7 +64,7 @@ type PortRange struct {
 	End   int `json:"end"`
 }
 
-// IPRange ip range definition.
+// IPRange ip range definition, start and end are ip addresses.
 type IPRange struct {
 	Start string `json:"start"`
 	End   string `json:"end"`
@@ -0,0 +1,130 @@
+// Code generated by mockgen from NetworkAccessManager method set; DO NOT EDIT.
+
+package networkaccessmanager
+
+import (
+	"errors"
+
+	"github.com/SSHcom/privx-sdk-go/v2/api/filters"
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
+)
+
+// Service is the interface of NetworkAccessManager client, it allows substitution of
+// the client in tests, see Fake.
+type Service interface {
+	// CreateNetworkTarget create network target.
+	CreateNetworkTarget(target *NetworkTarget) (response.Identifier, error)
+	// DeleteNetworkTarget delete network target by id.
+	DeleteNetworkTarget(targetID string) error
+	// DisableNetworkTarget disable network target by id.
+	DisableNetworkTarget(targetID string, disable NetworkTargetDisable) error
+	// GetNetworkTarget get network target by id.
+	GetNetworkTarget(targetID string) (*NetworkTarget, error)
+	// GetNetworkTargetTags get network target tags.
+	GetNetworkTargetTags(opts ...filters.Option) (*response.ResultSet[string], error)
+	// GetNetworkTargets get network targets.
+	GetNetworkTargets(opts ...filters.Option) (*response.ResultSet[NetworkTarget], error)
+	// SearchNetworkTargets search network target.
+	SearchNetworkTargets(search NetworkTargetSearch, opts ...filters.Option) (*response.ResultSet[NetworkTarget], error)
+	// Status get network access manager microservice status.
+	Status() (*response.ServiceStatus, error)
+	// UpdateNetworkTarget update network target.
+	UpdateNetworkTarget(targetID string, target *NetworkTarget) error
+}
+
+var (
+	_ Service = (*NetworkAccessManager)(nil)
+	_ Service = (*Fake)(nil)
+)
+
+// Fake is a fake of NetworkAccessManager client. Methods delegate to the corresponding
+// function fields, undefined functions return an error.
+type Fake struct {
+	CreateNetworkTargetFunc  func(*NetworkTarget) (response.Identifier, error)
+	DeleteNetworkTargetFunc  func(string) error
+	DisableNetworkTargetFunc func(string, NetworkTargetDisable) error
+	GetNetworkTargetFunc     func(string) (*NetworkTarget, error)
+	GetNetworkTargetTagsFunc func(...filters.Option) (*response.ResultSet[string], error)
+	GetNetworkTargetsFunc    func(...filters.Option) (*response.ResultSet[NetworkTarget], error)
+	SearchNetworkTargetsFunc func(NetworkTargetSearch, ...filters.Option) (*response.ResultSet[NetworkTarget], error)
+	StatusFunc               func() (*response.ServiceStatus, error)
+	UpdateNetworkTargetFunc  func(string, *NetworkTarget) error
+}
+
+// CreateNetworkTarget calls CreateNetworkTargetFunc.
+func (f *Fake) CreateNetworkTarget(target *NetworkTarget) (response.Identifier, error) {
+	if f.CreateNetworkTargetFunc == nil {
+		var r0 response.Identifier
+		return r0, errors.New("networkaccessmanager: Fake.CreateNetworkTarget is not implemented")
+	}
+	return f.CreateNetworkTargetFunc(target)
+}
+
+// DeleteNetworkTarget calls DeleteNetworkTargetFunc.
+func (f *Fake) DeleteNetworkTarget(targetID string) error {
+	if f.DeleteNetworkTargetFunc == nil {
+		return errors.New("networkaccessmanager: Fake.DeleteNetworkTarget is not implemented")
+	}
+	return f.DeleteNetworkTargetFunc(targetID)
+}
+
+// DisableNetworkTarget calls DisableNetworkTargetFunc.
+func (f *Fake) DisableNetworkTarget(targetID string, disable NetworkTargetDisable) error {
+	if f.DisableNetworkTargetFunc == nil {
+		return errors.New("networkaccessmanager: Fake.DisableNetworkTarget is not implemented")
+	}
+	return f.DisableNetworkTargetFunc(targetID, disable)
+}
+
+// GetNetworkTarget calls GetNetworkTargetFunc.
+func (f *Fake) GetNetworkTarget(targetID string) (*NetworkTarget, error) {
+	if f.GetNetworkTargetFunc == nil {
+		var r0 *NetworkTarget
+		return r0, errors.New("networkaccessmanager: Fake.GetNetworkTarget is not implemented")
+	}
+	return f.GetNetworkTargetFunc(targetID)
+}
+
+// GetNetworkTargetTags calls GetNetworkTargetTagsFunc.
+func (f *Fake) GetNetworkTargetTags(opts ...filters.Option) (*response.ResultSet[string], error) {
+	if f.GetNetworkTargetTagsFunc == nil {
+		var r0 *response.ResultSet[string]
+		return r0, errors.New("networkaccessmanager: Fake.GetNetworkTargetTags is not implemented")
+	}
+	return f.GetNetworkTargetTagsFunc(opts...)
+}
+
+// GetNetworkTargets calls GetNetworkTargetsFunc.
+func (f *Fake) GetNetworkTargets(opts ...filters.Option) (*response.ResultSet[NetworkTarget], error) {
+	if f.GetNetworkTargetsFunc == nil {
+		var r0 *response.ResultSet[NetworkTarget]
+		return r0, errors.New("networkaccessmanager: Fake.GetNetworkTargets is not implemented")
+	}
+	return f.GetNetworkTargetsFunc(opts...)
+}
+
+// SearchNetworkTargets calls SearchNetworkTargetsFunc.
+func (f *Fake) SearchNetworkTargets(search NetworkTargetSearch, opts ...filters.Option) (*response.ResultSet[NetworkTarget], error) {
+	if f.SearchNetworkTargetsFunc == nil {
+		var r0 *response.ResultSet[NetworkTarget]
+		return r0, errors.New("networkaccessmanager: Fake.SearchNetworkTargets is not implemented")
+	}
+	return f.SearchNetworkTargetsFunc(search, opts...)
+}
+
+// Status calls StatusFunc.
+func (f *Fake) Status() (*response.ServiceStatus, error) {
+	if f.StatusFunc == nil {
+		var r0 *response.ServiceStatus
+		return r0, errors.New("networkaccessmanager: Fake.Status is not implemented")
+	}
+	return f.StatusFunc()
+}
+
+// UpdateNetworkTarget calls UpdateNetworkTargetFunc.
+func (f *Fake) UpdateNetworkTarget(targetID string, target *NetworkTarget) error {
+	if f.UpdateNetworkTargetFunc == nil {
+		return errors.New("networkaccessmanager: Fake.UpdateNetworkTarget is not implemented")
+	}
+	return f.UpdateNetworkTargetFunc(targetID, target)
+}
@@ -0,0 +1,147 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package response
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Extra holds fields of response object which are not known by the model,
+// e.g. fields introduced by newer PrivX version.
+type Extra map[string]json.RawMessage
+
+// Decode decodes extra field into v, it returns false if the field is missing.
+func (e Extra) Decode(key string, v any) (bool, error) {
+	raw, ok := e[key]
+	if !ok {
+		return false, nil
+	}
+	return true, json.Unmarshal(raw, v)
+}
+
+/*
+DecodeExtra decodes JSON object into model v and returns the fields which
+are not known by the model. Models use it to implement json.Unmarshaler:
+
+	func (l *License) UnmarshalJSON(data []byte) (err error) {
+		type license License
+		l.Extra, err = response.DecodeExtra(data, (*license)(l))
+		return
+	}
+*/
+func DecodeExtra(data []byte, v any) (Extra, error) {
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil || fields == nil {
+		return nil, nil
+	}
+
+	known := map[string]bool{}
+	knownFields(reflect.TypeOf(v), known)
+
+	extra := Extra{}
+	for key, raw := range fields {
+		if !known[strings.ToLower(key)] {
+			extra[key] = raw
+		}
+	}
+
+	if len(extra) == 0 {
+		return nil, nil
+	}
+	return extra, nil
+}
+
+/*
+EncodeExtra encodes model v as JSON object and writes back the fields which
+are not known by the model, so that unknown fields survive a read-modify-write
+round trip. Fields of the model take precedence over extra fields. Models use
+it to implement json.Marshaler:
+
+	func (l License) MarshalJSON() ([]byte, error) {
+		type license License
+		return response.EncodeExtra(license(l), l.Extra)
+	}
+*/
+func EncodeExtra(v any, extra Extra) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return data, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil || fields == nil {
+		return data, err
+	}
+
+	keys := make([]string, 0, len(extra))
+	for key := range extra {
+		if _, ok := fields[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	if len(keys) == 0 {
+		return data, nil
+	}
+	sort.Strings(keys)
+
+	buf := bytes.NewBuffer(bytes.TrimSuffix(bytes.TrimSpace(data), []byte("}")))
+	for i, key := range keys {
+		if i > 0 || len(fields) > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(extra[key])
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// knownFields collects lower cased JSON names of struct fields
+func knownFields(t reflect.Type, known map[string]bool) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			knownFields(f.Type, known)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = f.Name
+		}
+		known[strings.ToLower(name)] = true
+	}
+}
@@ -0,0 +1,88 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package response
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type tModel struct {
+	Identifier
+	Name  string `json:"name"`
+	Count int
+	Extra Extra `json:"-"`
+}
+
+func (m *tModel) UnmarshalJSON(data []byte) (err error) {
+	type model tModel
+	m.Extra, err = DecodeExtra(data, (*model)(m))
+	return
+}
+
+func TestDecodeExtra(t *testing.T) {
+	var m tModel
+	if err := json.Unmarshal(
+		[]byte(`{"id": "1", "name": "x", "count": 2, "features": ["a"]}`),
+		&m,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	if m.ID != "1" || m.Name != "x" || m.Count != 2 {
+		t.Errorf("unexpected model: %+v", m)
+	}
+	if len(m.Extra) != 1 {
+		t.Errorf("unexpected extra fields: %v", m.Extra)
+	}
+
+	var features []string
+	if ok, err := m.Extra.Decode("features", &features); !ok || err != nil {
+		t.Fatalf("extra field is not decoded: %v", err)
+	}
+	if len(features) != 1 || features[0] != "a" {
+		t.Errorf("unexpected extra field: %v", features)
+	}
+
+	if ok, _ := m.Extra.Decode("missing", &features); ok {
+		t.Error("missing extra field is found")
+	}
+}
+
+func (m tModel) MarshalJSON() ([]byte, error) {
+	type model tModel
+	return EncodeExtra(model(m), m.Extra)
+}
+
+func TestEncodeExtra(t *testing.T) {
+	var m tModel
+	if err := json.Unmarshal(
+		[]byte(`{"id": "1", "name": "x", "count": 2, "features": ["a"], "mode": "dark"}`),
+		&m,
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	m.Name = "y"
+	m.Extra["name"] = json.RawMessage(`"z"`)
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := string(data); s != `{"id":"1","name":"y","Count":2,"features":["a"],"mode":"dark"}` {
+		t.Errorf("unexpected encoding: %s", s)
+	}
+
+	data, err = json.Marshal(tModel{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := string(data); s != `{"id":"","name":"","Count":0}` {
+		t.Errorf("unexpected encoding: %s", s)
+	}
+}
@@ -0,0 +1,136 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package response
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// timestampLayouts are formats of timestamps used by PrivX services.
+// Timestamps without zone are in UTC.
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z0700",
+	"2006-01-02 15:04:05.999999999 -0700 MST",
+	"2006-01-02 15:04:05.999999999",
+	time.DateOnly,
+}
+
+/*
+Timestamp is a point in time returned by PrivX services. It accepts every
+timestamp format used by PrivX, including unix time, and marshals back the
+original value unless the time is changed. Empty and null timestamps are zero.
+
+	sort.Slice(hosts, func(i, j int) bool {
+		return hosts[i].Created.Before(hosts[j].Created.Time)
+	})
+*/
+type Timestamp struct {
+	time.Time
+
+	// raw is the original JSON value and at the time parsed from it
+	raw string
+	at  time.Time
+}
+
+// ParseTimestamp parses timestamp in any of formats used by PrivX.
+func ParseTimestamp(s string) (Timestamp, error) {
+	data, _ := json.Marshal(s)
+
+	var t Timestamp
+	if err := t.UnmarshalJSON(data); err != nil {
+		return Timestamp{}, err
+	}
+	return t, nil
+}
+
+// MarshalJSON encodes timestamp as original value or RFC 3339 string.
+func (t Timestamp) MarshalJSON() ([]byte, error) {
+	if t.raw != "" && t.Time.Equal(t.at) {
+		return []byte(t.raw), nil
+	}
+
+	if t.Time.IsZero() {
+		return []byte(`""`), nil
+	}
+
+	return json.Marshal(t.Time.Format(time.RFC3339Nano))
+}
+
+// UnmarshalJSON decodes timestamp from string or unix time.
+func (t *Timestamp) UnmarshalJSON(data []byte) error {
+	raw := strings.TrimSpace(string(data))
+
+	at, err := parseTimestamp(raw)
+	if err != nil {
+		return err
+	}
+
+	*t = Timestamp{Time: at, raw: raw, at: at}
+	return nil
+}
+
+// String returns the original value of timestamp or RFC 3339 string.
+func (t Timestamp) String() string {
+	if t.raw != "" && t.Time.Equal(t.at) {
+		if s, err := strconv.Unquote(t.raw); err == nil {
+			return s
+		}
+	}
+
+	if t.Time.IsZero() {
+		return ""
+	}
+
+	return t.Time.Format(time.RFC3339Nano)
+}
+
+// parseTimestamp parses JSON value of timestamp
+func parseTimestamp(raw string) (time.Time, error) {
+	if raw == "null" {
+		return time.Time{}, nil
+	}
+
+	if !strings.HasPrefix(raw, `"`) {
+		sec, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid timestamp: %s", raw)
+		}
+		// unix time in milliseconds exceeds seconds of any sane date
+		if sec > 1e11 || sec < -1e11 {
+			return time.UnixMilli(int64(sec)).UTC(), nil
+		}
+		whole, frac := math.Modf(sec)
+		return time.Unix(int64(whole), int64(math.Round(frac*1e6))*1e3).UTC(), nil
+	}
+
+	var s string
+	if err := json.Unmarshal([]byte(raw), &s); err != nil {
+		return time.Time{}, fmt.Errorf("invalid timestamp: %s", raw)
+	}
+
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, nil
+	}
+
+	for _, layout := range timestampLayouts {
+		if at, err := time.Parse(layout, s); err == nil {
+			return at, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid timestamp: %s", raw)
+}
@@ -0,0 +1,82 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package response
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestTimestampFormats(t *testing.T) {
+	expect := time.Date(2024, 5, 6, 7, 8, 9, 100000000, time.UTC)
+
+	for _, raw := range []string{
+		`"2024-05-06T07:08:09.1Z"`,
+		`"2024-05-06T07:08:09.100Z"`,
+		`"2024-05-06T10:08:09.100+03:00"`,
+		`"2024-05-06T10:08:09.100+0300"`,
+		`"2024-05-06T07:08:09.100"`,
+		`"2024-05-06 07:08:09.1"`,
+		`"2024-05-06 07:08:09.1 +0000 UTC"`,
+		`1714979289.1`,
+		`1714979289100`,
+	} {
+		var ts Timestamp
+		if err := json.Unmarshal([]byte(raw), &ts); err != nil {
+			t.Errorf("%s: %v", raw, err)
+			continue
+		}
+		if !ts.Equal(expect) {
+			t.Errorf("%s: unexpected time %v", raw, ts.Time)
+		}
+
+		data, err := json.Marshal(ts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != raw {
+			t.Errorf("%s: not marshalled losslessly: %s", raw, data)
+		}
+	}
+}
+
+func TestTimestampEmpty(t *testing.T) {
+	for _, raw := range []string{`""`, `null`} {
+		var ts Timestamp
+		if err := json.Unmarshal([]byte(raw), &ts); err != nil {
+			t.Fatal(err)
+		}
+		if !ts.IsZero() {
+			t.Errorf("%s: timestamp is not zero", raw)
+		}
+	}
+
+	var ts Timestamp
+	if err := json.Unmarshal([]byte(`"yesterday"`), &ts); err == nil {
+		t.Error("invalid timestamp is accepted")
+	}
+}
+
+func TestTimestampChanged(t *testing.T) {
+	var v struct {
+		Created Timestamp `json:"created"`
+		Updated Timestamp `json:"updated,omitzero"`
+	}
+	if err := json.Unmarshal([]byte(`{"created": "2024-05-06T07:08:09.100Z", "updated": ""}`), &v); err != nil {
+		t.Fatal(err)
+	}
+
+	v.Created.Time = v.Created.Add(time.Hour)
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"created":"2024-05-06T08:08:09.1Z"}` {
+		t.Errorf("unexpected encoding: %s", data)
+	}
+}
@@ -6,8 +6,9 @@
 
 package rolestore
 
+//go:generate go run github.com/SSHcom/privx-sdk-go/v2/internal/mockgen
+
 import (
-	"encoding/json"
 	"net/url"
 
 	"github.com/SSHcom/privx-sdk-go/v2/api/filters"
@@ -104,8 +105,8 @@ func (c *RoleStore) GetAWSRoles(opts ...filters.Option) (*response.ResultSet[AWS
 	roles := &response.ResultSet[AWSRole]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -169,8 +170,8 @@ func (c *RoleStore) GetUser(userID string) (*User, error) {
 }
 
 // GetUserSettings get user settings.
-func (c *RoleStore) GetUserSettings(userID string) (*json.RawMessage, error) {
-	settings := &json.RawMessage{}
+func (c *RoleStore) GetUserSettings(userID string) (*UserSettings, error) {
+	settings := &UserSettings{}
 
 	_, err := c.api.
 		URL("/role-store/api/v1/users/%s/settings", userID).
@@ -217,8 +218,8 @@ func (c *RoleStore) SetMFA(userIDs []string, action MFAAction) error {
 }
 
 // GetCurrentUserInfo get current user and user settings.
-func (c *RoleStore) GetCurrentUserInfo() (*json.RawMessage, error) {
-	current := &json.RawMessage{}
+func (c *RoleStore) GetCurrentUserInfo() (*CurrentUser, error) {
+	current := &CurrentUser{}
 
 	_, err := c.api.
 		URL("/role-store/api/v1/users/current").
@@ -227,7 +228,7 @@ func (c *RoleStore) GetCurrentUserInfo() (*json.RawMessage, error) {
 	return current, err
 }
 
-// GetCurrentUserSettings get current user AWS roles.
+// GetCurrentAWSRoles get current user AWS roles.
 func (c *RoleStore) GetCurrentAWSRoles() (*response.ResultSet[AWSRole], error) {
 	roles := &response.ResultSet[AWSRole]{}
 
@@ -238,9 +239,9 @@ func (c *RoleStore) GetCurrentAWSRoles() (*response.ResultSet[AWSRole], error) {
 	return roles, err
 }
 
-// GetCurrentUserAndSettings get current user settings.
-func (c *RoleStore) GetCurrentUserSettings() (*json.RawMessage, error) {
-	settings := &json.RawMessage{}
+// GetCurrentUserSettings get current user settings.
+func (c *RoleStore) GetCurrentUserSettings() (*UserSettings, error) {
+	settings := &UserSettings{}
 
 	_, err := c.api.
 		URL("/role-store/api/v1/users/current/settings").
@@ -274,8 +275,8 @@ func (c *RoleStore) SearchUsers(search UserSearch, opts ...filters.Option) (*res
 	users := &response.ResultSet[User]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -302,8 +303,8 @@ func (c *RoleStore) GetUsersAuthorizedKeys(userID string, opts ...filters.Option
 	keys := &response.ResultSet[AuthorizedKey]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -359,8 +360,8 @@ func (c *RoleStore) GetCurrentUserAuthorizedKeys(opts ...filters.Option) (*respo
 	keys := &response.ResultSet[AuthorizedKey]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -416,8 +417,8 @@ func (c *RoleStore) GetRoles(opts ...filters.Option) (*response.ResultSet[Role],
 	roles := &response.ResultSet[Role]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -430,6 +431,10 @@ func (c *RoleStore) GetRoles(opts ...filters.Option) (*response.ResultSet[Role],
 
 // CreateRole creates role.
 func (c *RoleStore) CreateRole(role *Role) (response.Identifier, error) {
+	if err := role.Validate(); err != nil {
+		return response.Identifier{}, err
+	}
+
 	identifier := response.Identifier{}
 
 	_, err := c.api.
@@ -455,8 +460,8 @@ func (c *RoleStore) SearchRoles(search RoleSearch, opts ...filters.Option) (*res
 	roles := &response.ResultSet[Role]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -491,6 +496,10 @@ func (c *RoleStore) GetRole(roleID string) (*Role, error) {
 
 // UpdateRole update role.
 func (c *RoleStore) UpdateRole(roleID string, role *Role) error {
+	if err := role.Validate(); err != nil {
+		return err
+	}
+
 	_, err := c.api.
 		URL("/role-store/api/v1/roles/%s", roleID).
 		Put(&role)
@@ -512,8 +521,8 @@ func (c *RoleStore) GetRoleMembers(roleID string, opts ...filters.Option) (*resp
 	users := &response.ResultSet[User]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -525,12 +534,12 @@ func (c *RoleStore) GetRoleMembers(roleID string, opts ...filters.Option) (*resp
 }
 
 // GetAWSToken get AWS token for role.
-func (c *RoleStore) GetAWSToken(roleID string, opts ...filters.Option) (*json.RawMessage, error) {
-	token := &json.RawMessage{}
+func (c *RoleStore) GetAWSToken(roleID string, opts ...filters.Option) (*AWSToken, error) {
+	token := &AWSToken{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -600,8 +609,8 @@ func (c *RoleStore) GetIdentityProviders(opts ...filters.Option) (*response.Resu
 	providers := &response.ResultSet[IdentityProvider]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -657,8 +666,8 @@ func (c *RoleStore) SearchIdentityProviders(search IdentityProviderSearch, opts
 	providers := &response.ResultSet[IdentityProvider]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -675,8 +684,8 @@ func (c *RoleStore) GetAuthorizedKeys(opts ...filters.Option) (*response.ResultS
 	keys := &response.ResultSet[AuthorizedKey]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 
 	"github.com/SSHcom/privx-sdk-go/v2/api/auth"
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
 )
 
 const (
@@ -22,6 +23,16 @@ const (
 // MFAAction definition for possible actions related to MFA.
 type MFAAction string
 
+const (
+	// Enumerated values for role grant types.
+	GrantPermanent      GrantType = "PERMANENT"
+	GrantTimeRestricted GrantType = "TIME_RESTRICTED"
+	GrantFloating       GrantType = "FLOATING"
+)
+
+// GrantType definition for possible types of role grants.
+type GrantType string
+
 // AWSRoleParams aws role query parameter definition.
 type AWSRoleParams struct {
 	Refresh bool `url:"refresh,omitempty"`
@@ -46,35 +57,35 @@ type RolePrincipalKey struct {
 
 // LogConfCollector logconf collectors definition.
 type LogConfCollector struct {
-	ID                  string `json:"id,omitempty"`
-	Name                string `json:"name,omitempty"`
-	Type                string `json:"type,omitempty"`
-	Enabled             bool   `json:"enabled"`
-	Updated             string `json:"updated,omitempty"`
-	StatusCode          string `json:"status_code,omitempty"`
-	StatusText          string `json:"status_text,omitempty"`
-	AWSLogRegion        string `json:"aws_log_region,omitempty"`
-	IAMAccessKeyID      string `json:"iam_access_key_id,omitempty"`
-	IAMSecretAccessKey  string `json:"iam_secret_access_key,omitempty"`
-	IAMSessionToken     string `json:"iam_session_token,omitempty"`
-	Namespace           string `json:"azure_event_hubs_namespace,omitempty"`
-	EventHubName        string `json:"azure_event_hub_name,omitempty"`
-	TenantID            string `json:"azure_tenant_id,omitempty"`
-	ClientID            string `json:"azure_client_id,omitempty"`
-	ClientSecret        string `json:"azure_client_secret,omitempty"`
-	SASConnectionString string `json:"azure_sas_connection_string,omitempty"`
+	ID                  string             `json:"id,omitempty"`
+	Name                string             `json:"name,omitempty"`
+	Type                string             `json:"type,omitempty"`
+	Enabled             bool               `json:"enabled"`
+	Updated             response.Timestamp `json:"updated,omitzero"`
+	StatusCode          string             `json:"status_code,omitempty"`
+	StatusText          string             `json:"status_text,omitempty"`
+	AWSLogRegion        string             `json:"aws_log_region,omitempty"`
+	IAMAccessKeyID      string             `json:"iam_access_key_id,omitempty"`
+	IAMSecretAccessKey  string             `json:"iam_secret_access_key,omitempty"`
+	IAMSessionToken     string             `json:"iam_session_token,omitempty"`
+	Namespace           string             `json:"azure_event_hubs_namespace,omitempty"`
+	EventHubName        string             `json:"azure_event_hub_name,omitempty"`
+	TenantID            string             `json:"azure_tenant_id,omitempty"`
+	ClientID            string             `json:"azure_client_id,omitempty"`
+	ClientSecret        string             `json:"azure_client_secret,omitempty"`
+	SASConnectionString string             `json:"azure_sas_connection_string,omitempty"`
 }
 
 // AWSRole aws role definition.
 type AWSRole struct {
-	ID          string            `json:"id,omitempty"`
-	DisplayName string            `json:"name,omitempty"`
-	ARN         string            `json:"arn,omitempty"`
-	Description string            `json:"description,omitempty"`
-	Source      string            `json:"source,omitempty"`
-	Status      string            `json:"status,omitempty"`
-	Roles       []LinkedPrivXRole `json:"roles,omitempty"`
-	Updated     string            `json:"updated,omitempty"`
+	ID          string             `json:"id,omitempty"`
+	DisplayName string             `json:"name,omitempty"`
+	ARN         string             `json:"arn,omitempty"`
+	Description string             `json:"description,omitempty"`
+	Source      string             `json:"source,omitempty"`
+	Status      string             `json:"status,omitempty"`
+	Roles       []LinkedPrivXRole  `json:"roles,omitempty"`
+	Updated     response.Timestamp `json:"updated,omitzero"`
 }
 
 type LinkedPrivXRole struct {
@@ -86,8 +97,8 @@ type LinkedPrivXRole struct {
 type Source struct {
 	ID                          string                      `json:"id,omitempty"`
 	Name                        string                      `json:"name,omitempty"`
-	Created                     string                      `json:"created,omitempty"`
-	Updated                     string                      `json:"updated,omitempty"`
+	Created                     response.Timestamp          `json:"created,omitzero"`
+	Updated                     response.Timestamp          `json:"updated,omitzero"`
 	Author                      string                      `json:"author,omitempty"`
 	UpdatedBy                   string                      `json:"updatedby,omitempty"`
 	TTL                         int                         `json:"ttl,omitempty"`
@@ -251,42 +262,42 @@ type SourceConnection struct {
 
 // User user definition.
 type User struct {
-	ID                     string          `json:"id,omitempty"`
-	SourceUserID           string          `json:"source_user_id,omitempty"`
-	Created                string          `json:"created,omitempty"`
-	Updated                string          `json:"updated,omitempty"`
-	Author                 string          `json:"author,omitempty"`
-	UpdatedBy              string          `json:"updatedby,omitempty"`
-	Principal              string          `json:"principal,omitempty"`
-	Source                 string          `json:"source,omitempty"`
-	SourceType             string          `json:"source_type,omitempty"`
-	Comment                string          `json:"comment,omitempty"`
-	Tags                   []string        `json:"tags,omitempty"`
-	Roles                  []Role          `json:"roles"`
-	Attributes             []UserAttribute `json:"attributes"`
-	Permissions            []string        `json:"permissions"`
-	FirstName              string          `json:"first_name,omitempty"`
-	LastName               string          `json:"last_name,omitempty"`
-	FullName               string          `json:"full_name,omitempty"`
-	JobTitle               string          `json:"job_title,omitempty"`
-	Company                string          `json:"company,omitempty"`
-	Department             string          `json:"department,omitempty"`
-	Email                  string          `json:"email,omitempty"`
-	Telephone              string          `json:"telephone,omitempty"`
-	DistinguishedName      string          `json:"distinguished_name,omitempty"`
-	Locale                 string          `json:"locale,omitempty"`
-	SamAccountName         string          `json:"samaccountname,omitempty"`
-	WindowsAccount         string          `json:"windows_account,omitempty"`
-	UnixAccount            string          `json:"unix_account,omitempty"`
-	Password               string          `json:"password,omitempty"`
-	MFA                    MFAStatus       `json:"mfa,omitempty"`
-	Settings               json.RawMessage `json:"settings,omitempty"`
-	ExternalID             string          `json:"external_id,omitempty"`
-	AuthorizedKeys         []AuthorizedKey `json:"authorized_keys,omitempty"`
-	WebAuthnCredentials    []Credential    `json:"webauthn_credentials,omitempty"`
-	SessionPasswordEnabled bool            `json:"session_password_enabled,omitempty"`
-	StaleAccessToken       bool            `json:"stale_access_token,omitempty"`
-	CurrentSessionID       string          `json:"current_session_id,omitempty"`
+	ID                     string             `json:"id,omitempty"`
+	SourceUserID           string             `json:"source_user_id,omitempty"`
+	Created                response.Timestamp `json:"created,omitzero"`
+	Updated                response.Timestamp `json:"updated,omitzero"`
+	Author                 string             `json:"author,omitempty"`
+	UpdatedBy              string             `json:"updatedby,omitempty"`
+	Principal              string             `json:"principal,omitempty"`
+	Source                 string             `json:"source,omitempty"`
+	SourceType             string             `json:"source_type,omitempty"`
+	Comment                string             `json:"comment,omitempty"`
+	Tags                   []string           `json:"tags,omitempty"`
+	Roles                  []Role             `json:"roles"`
+	Attributes             []UserAttribute    `json:"attributes"`
+	Permissions            []string           `json:"permissions"`
+	FirstName              string             `json:"first_name,omitempty"`
+	LastName               string             `json:"last_name,omitempty"`
+	FullName               string             `json:"full_name,omitempty"`
+	JobTitle               string             `json:"job_title,omitempty"`
+	Company                string             `json:"company,omitempty"`
+	Department             string             `json:"department,omitempty"`
+	Email                  string             `json:"email,omitempty"`
+	Telephone              string             `json:"telephone,omitempty"`
+	DistinguishedName      string             `json:"distinguished_name,omitempty"`
+	Locale                 string             `json:"locale,omitempty"`
+	SamAccountName         string             `json:"samaccountname,omitempty"`
+	WindowsAccount         string             `json:"windows_account,omitempty"`
+	UnixAccount            string             `json:"unix_account,omitempty"`
+	Password               string             `json:"password,omitempty"`
+	MFA                    MFAStatus          `json:"mfa,omitempty"`
+	Settings               json.RawMessage    `json:"settings,omitempty"`
+	ExternalID             string             `json:"external_id,omitempty"`
+	AuthorizedKeys         []AuthorizedKey    `json:"authorized_keys,omitempty"`
+	WebAuthnCredentials    []Credential       `json:"webauthn_credentials,omitempty"`
+	SessionPasswordEnabled bool               `json:"session_password_enabled,omitempty"`
+	StaleAccessToken       bool               `json:"stale_access_token,omitempty"`
+	CurrentSessionID       string             `json:"current_session_id,omitempty"`
 	RefreshTimestamp       string
 
 	// Ephemeral/OIDC users' implicit roles expiration date.
@@ -300,15 +311,15 @@ type User struct {
 
 // Credential webauthn credential definition.
 type Credential struct {
-	ID           string `json:"id"`
-	CredentialID string `json:"credential_id"`
-	Name         string `json:"name,omitempty" diff:"name"`
-	Comment      string `json:"comment,omitempty" diff:"comment"`
-	LastUsed     string `json:"last_used,omitempty"`
-	Created      string `json:"created,omitempty"`
-	Author       string `json:"author,omitempty"`
-	Updated      string `json:"updated,omitempty"`
-	UpdatedBy    string `json:"updated_by,omitempty"`
+	ID           string             `json:"id"`
+	CredentialID string             `json:"credential_id"`
+	Name         string             `json:"name,omitempty" diff:"name"`
+	Comment      string             `json:"comment,omitempty" diff:"comment"`
+	LastUsed     response.Timestamp `json:"last_used,omitzero"`
+	Created      response.Timestamp `json:"created,omitzero"`
+	Author       string             `json:"author,omitempty"`
+	Updated      response.Timestamp `json:"updated,omitzero"`
+	UpdatedBy    string             `json:"updated_by,omitempty"`
 }
 
 // MFAStatus mfa status definition.
@@ -332,23 +343,23 @@ type UserAttribute struct {
 
 // AuthorizedKey authorized key definition.
 type AuthorizedKey struct {
-	ID                    string   `json:"id"`
-	UserID                string   `json:"user_id,omitempty"`
-	Username              string   `json:"username,omitempty"`
-	Source                string   `json:"source,omitempty"`
-	PublicKey             string   `json:"public_key,omitempty"`
-	NotBefore             string   `json:"not_before,omitempty"`
-	NotAfter              string   `json:"not_after,omitempty"`
-	ExpiresIn             int64    `json:"expires_in,omitempty"`
-	SourceAddress         []string `json:"source_address"`
-	Fingerprints          []string `json:"fingerprints,omitempty"`
-	EnableInteractiveAuth bool     `json:"enable_interactive_auth,omitempty"`
-	Name                  string   `json:"name,omitempty"`
-	Comment               string   `json:"comment,omitempty"`
-	Created               string   `json:"created,omitempty"`
-	Updated               string   `json:"updated,omitempty"`
-	UpdatedBy             string   `json:"updated_by,omitempty"`
-	Author                string   `json:"author,omitempty"`
+	ID                    string             `json:"id"`
+	UserID                string             `json:"user_id,omitempty"`
+	Username              string             `json:"username,omitempty"`
+	Source                string             `json:"source,omitempty"`
+	PublicKey             string             `json:"public_key,omitempty"`
+	NotBefore             response.Timestamp `json:"not_before,omitzero"`
+	NotAfter              response.Timestamp `json:"not_after,omitzero"`
+	ExpiresIn             int64              `json:"expires_in,omitempty"`
+	SourceAddress         []string           `json:"source_address"`
+	Fingerprints          []string           `json:"fingerprints,omitempty"`
+	EnableInteractiveAuth bool               `json:"enable_interactive_auth,omitempty"`
+	Name                  string             `json:"name,omitempty"`
+	Comment               string             `json:"comment,omitempty"`
+	Created               response.Timestamp `json:"created,omitzero"`
+	Updated               response.Timestamp `json:"updated,omitzero"`
+	UpdatedBy             string             `json:"updated_by,omitempty"`
+	Author                string             `json:"author,omitempty"`
 }
 
 // UserSettings user settings update request definition.
@@ -358,6 +369,63 @@ type UserSettings struct {
 	SSHClient         UserSSHClient           `json:"sshClient,omitempty"`
 	ConnectionHistory []UserConnectionHistory `json:"connectionHistory,omitempty"`
 	Bookmarks         UserBookmarks           `json:"bookmarks,omitempty"`
+
+	// Extra holds settings unknown to the model.
+	Extra response.Extra `json:"-"`
+}
+
+func (s *UserSettings) UnmarshalJSON(data []byte) (err error) {
+	type settings UserSettings
+	s.Extra, err = response.DecodeExtra(data, (*settings)(s))
+	return
+}
+
+func (s UserSettings) MarshalJSON() ([]byte, error) {
+	type settings UserSettings
+	return response.EncodeExtra(settings(s), s.Extra)
+}
+
+// CurrentUser current user and user settings response definition.
+type CurrentUser struct {
+	User
+	Settings UserSettings `json:"settings"`
+
+	// Extra holds fields unknown to the model.
+	Extra response.Extra `json:"-"`
+}
+
+func (u *CurrentUser) UnmarshalJSON(data []byte) (err error) {
+	type user CurrentUser
+	u.Extra, err = response.DecodeExtra(data, (*user)(u))
+	return
+}
+
+func (u CurrentUser) MarshalJSON() ([]byte, error) {
+	type user CurrentUser
+	return response.EncodeExtra(user(u), u.Extra)
+}
+
+// AWSToken temporary AWS credentials response definition.
+type AWSToken struct {
+	AccessKeyID     string             `json:"access_key_id"`
+	SecretAccessKey string             `json:"secret_key"`
+	SessionToken    string             `json:"session_token"`
+	Expires         response.Timestamp `json:"expires_at,omitzero"`
+	Description     string             `json:"description,omitempty"`
+
+	// Extra holds fields unknown to the model.
+	Extra response.Extra `json:"-"`
+}
+
+func (t *AWSToken) UnmarshalJSON(data []byte) (err error) {
+	type token AWSToken
+	t.Extra, err = response.DecodeExtra(data, (*token)(t))
+	return
+}
+
+func (t AWSToken) MarshalJSON() ([]byte, error) {
+	type token AWSToken
+	return response.EncodeExtra(token(t), t.Extra)
 }
 
 // Bookmark bookmark user settings definition.
@@ -395,14 +463,14 @@ type UserBookmarks struct {
 
 // UserConnectionHistory user connection history settings definition.
 type UserConnectionHistory struct {
-	Id          string `json:"id"`
-	Time        string `json:"time"`
-	Type        string `json:"type"`
-	Target      string `json:"target"`
-	TargetID    string `json:"targetId,omitempty"`
-	Account     string `json:"account,omitempty"`
-	Name        string `json:"name,omitempty"`
-	Application string `json:"application,omitempty"`
+	Id          string             `json:"id"`
+	Time        response.Timestamp `json:"time"`
+	Type        string             `json:"type"`
+	Target      string             `json:"target"`
+	TargetID    string             `json:"targetId,omitempty"`
+	Account     string             `json:"account,omitempty"`
+	Name        string             `json:"name,omitempty"`
+	Application string             `json:"application,omitempty"`
 }
 
 // UserSSHClient user ssh client settings definition.
@@ -441,34 +509,34 @@ type RoleSearch struct {
 
 // Role PrivX role definition.
 type Role struct {
-	ID                   string           `json:"id"`
-	Type                 string           `json:"type,omitempty"`
-	AwsArn               string           `json:"arn,omitempty"`
-	Created              string           `json:"created,omitempty"`
-	Name                 string           `json:"name,omitempty"`
-	Updated              string           `json:"updated,omitempty"`
-	Author               string           `json:"author,omitempty"`
-	UpdatedBy            string           `json:"updatedby,omitempty"`
-	Explicit             bool             `json:"explicit"`
-	Implicit             bool             `json:"implicit"`
-	System               bool             `json:"system"`
-	GrantType            string           `json:"grant_type,omitempty"`
-	GrantStart           string           `json:"grant_start,omitempty"`
-	GrantEnd             string           `json:"grant_end,omitempty"`
-	GrantValidityPeriods []ValidityPeriod `json:"grant_validity_periods,omitempty"`
-	FloatingLength       int64            `json:"floating_length,omitempty"`
-	Comment              string           `json:"comment,omitempty"`
-	Tags                 []string         `json:"tags,omitempty"`
-	AwsSource            string           `json:"source,omitempty"`
-	Permissions          []string         `json:"permissions"`
-	AccessGroupID        string           `json:"access_group_id"`
-	CreatedByDirectory   string           `json:"owner_src"`
-	PrincipalPublicKeys  []string         `json:"principal_public_key_strings,omitempty"`
-	PermitAgent          bool             `json:"permit_agent,omitempty"`
-	SourceRules          SourceRule       `json:"source_rules,omitempty"`
-	Context              ContextualLimit  `json:"context"`
-	MemberCount          *int             `json:"member_count,omitempty"`
-	ExplicitMemberCount  *int             `json:"explicit_count,omitempty"`
+	ID                   string             `json:"id"`
+	Type                 string             `json:"type,omitempty"`
+	AwsArn               string             `json:"arn,omitempty"`
+	Created              response.Timestamp `json:"created,omitzero"`
+	Name                 string             `json:"name,omitempty"`
+	Updated              response.Timestamp `json:"updated,omitzero"`
+	Author               string             `json:"author,omitempty"`
+	UpdatedBy            string             `json:"updatedby,omitempty"`
+	Explicit             bool               `json:"explicit"`
+	Implicit             bool               `json:"implicit"`
+	System               bool               `json:"system"`
+	GrantType            GrantType          `json:"grant_type,omitempty"`
+	GrantStart           response.Timestamp `json:"grant_start,omitzero"`
+	GrantEnd             response.Timestamp `json:"grant_end,omitzero"`
+	GrantValidityPeriods []ValidityPeriod   `json:"grant_validity_periods,omitempty"`
+	FloatingLength       int64              `json:"floating_length,omitempty"`
+	Comment              string             `json:"comment,omitempty"`
+	Tags                 []string           `json:"tags,omitempty"`
+	AwsSource            string             `json:"source,omitempty"`
+	Permissions          []string           `json:"permissions"`
+	AccessGroupID        string             `json:"access_group_id"`
+	CreatedByDirectory   string             `json:"owner_src"`
+	PrincipalPublicKeys  []string           `json:"principal_public_key_strings,omitempty"`
+	PermitAgent          bool               `json:"permit_agent,omitempty"`
+	SourceRules          SourceRule         `json:"source_rules,omitempty"`
+	Context              ContextualLimit    `json:"context"`
+	MemberCount          *int               `json:"member_count,omitempty"`
+	ExplicitMemberCount  *int               `json:"explicit_count,omitempty"`
 }
 
 type SourceRule struct {
@@ -479,6 +547,8 @@ type SourceRule struct {
 	SourceRules  []SourceRule `json:"rules"`
 }
 
+// ContextualLimit contextual limit definition, start and end times are times
+// of day in the time zone rather than timestamps.
 type ContextualLimit struct {
 	Enabled   bool     `json:"enabled"`
 	BlockRole bool     `json:"block_role,omitempty"`
@@ -491,8 +561,8 @@ type ContextualLimit struct {
 
 // ValidityPeriod validity period definition.
 type ValidityPeriod struct {
-	GrantStart string `json:"grant_start,omitempty"`
-	GrantEnd   string `json:"grant_end,omitempty"`
+	GrantStart response.Timestamp `json:"grant_start,omitzero"`
+	GrantEnd   response.Timestamp `json:"grant_end,omitzero"`
 }
 
 // RoleHandle role handle definition.
@@ -532,8 +602,8 @@ type IdentityProvider struct {
 	UsersDirectory                string                      `json:"users_directory"`
 	Enabled                       bool                        `json:"enabled"`
 	Author                        string                      `json:"author"`
-	Created                       string                      `json:"created"`
-	Updated                       string                      `json:"updated,omitempty"`
+	Created                       response.Timestamp          `json:"created"`
+	Updated                       response.Timestamp          `json:"updated,omitzero"`
 	UpdatedBy                     string                      `json:"updated_by,omitempty"`
 }
 
@@ -0,0 +1,761 @@
+// Code generated by mockgen from RoleStore method set; DO NOT EDIT.
+
+package rolestore
+
+import (
+	"errors"
+
+	"github.com/SSHcom/privx-sdk-go/v2/api/filters"
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
+)
+
+// Service is the interface of RoleStore client, it allows substitution of
+// the client in tests, see Fake.
+type Service interface {
+	// CreateCurrentUserAuthorizedKey create authorized key for current user.
+	CreateCurrentUserAuthorizedKey(key *AuthorizedKey) (response.Identifier, error)
+	// CreateIdentityProvider create a identity provider.
+	CreateIdentityProvider(provider *IdentityProvider) (response.Identifier, error)
+	// CreateLogConfCollector create logconf collector.
+	CreateLogConfCollector(collector *LogConfCollector) (response.Identifier, error)
+	// CreatePrincipalKey create principal key for role.
+	CreatePrincipalKey(roleID string) (response.Identifier, error)
+	// CreateRole creates role.
+	CreateRole(role *Role) (response.Identifier, error)
+	// CreateSource create source.
+	CreateSource(source *Source) (response.Identifier, error)
+	// CreateUserAuthorizedKey create authorized key for user.
+	CreateUserAuthorizedKey(userID string, key *AuthorizedKey) (response.Identifier, error)
+	// DeleteAWSRole delete AWS role.
+	DeleteAWSRole(awsRoleID string) error
+	// DeleteCurrentUserAuthorizedKey delete current a user authorized key.
+	DeleteCurrentUserAuthorizedKey(keyID string) error
+	// DeleteIdentityProvider delete identity provider by id.
+	DeleteIdentityProvider(providerID string) error
+	// DeleteLogConfCollector delete logconf collector.
+	DeleteLogConfCollector(collectorID string) error
+	// DeletePrincipalKey delete roles principal key.
+	DeletePrincipalKey(roleID string, keyID string) error
+	// DeleteRole delete role.
+	DeleteRole(roleID string) error
+	// DeleteSource delete source.
+	DeleteSource(sourceID string) error
+	// DeleteUserAuthorizedKey delete a user authorized key.
+	DeleteUserAuthorizedKey(userID string, keyID string) error
+	// EvaluateRole evaluate role definition.
+	EvaluateRole(role *Role) (*response.ResultSet[User], error)
+	// GetAWSRole get AWS role by id.
+	GetAWSRole(awsRoleID string) (*AWSRole, error)
+	// GetAWSRoles get AWS roles.
+	GetAWSRoles(opts ...filters.Option) (*response.ResultSet[AWSRole], error)
+	// GetAWSToken get AWS token for role.
+	GetAWSToken(roleID string, opts ...filters.Option) (*AWSToken, error)
+	// GetAuthorizedKeys get authorized keys.
+	GetAuthorizedKeys(opts ...filters.Option) (*response.ResultSet[AuthorizedKey], error)
+	// GetCurrentAWSRoles get current user AWS roles.
+	GetCurrentAWSRoles() (*response.ResultSet[AWSRole], error)
+	// GetCurrentUserAuthorizedKey get current user authorized key by id.
+	GetCurrentUserAuthorizedKey(keyID string) (*AuthorizedKey, error)
+	// GetCurrentUserAuthorizedKeys get current user authorized keys.
+	GetCurrentUserAuthorizedKeys(opts ...filters.Option) (*response.ResultSet[AuthorizedKey], error)
+	// GetCurrentUserInfo get current user and user settings.
+	GetCurrentUserInfo() (*CurrentUser, error)
+	// GetCurrentUserSettings get current user settings.
+	GetCurrentUserSettings() (*UserSettings, error)
+	// GetIdentityProvider get identity provider by id.
+	GetIdentityProvider(providerID string) (*IdentityProvider, error)
+	// GetIdentityProviders get identity providers.
+	GetIdentityProviders(opts ...filters.Option) (*response.ResultSet[IdentityProvider], error)
+	// GetLinkedRoles get AWS role granting PrivX roles.
+	GetLinkedRoles(awsRoleID string) (*response.ResultSet[LinkedPrivXRole], error)
+	// GetLogConfCollector get logconf collector by id.
+	GetLogConfCollector(collectorID string) (*LogConfCollector, error)
+	// GetLogConfCollectors get logconf collectors.
+	GetLogConfCollectors() (*response.ResultSet[LogConfCollector], error)
+	// GetPrincipalKey get roles principal key.
+	GetPrincipalKey(roleID string, keyID string) (RolePrincipalKey, error)
+	// GetPrincipalKeys get roles principal keys.
+	GetPrincipalKeys(roleID string) (*response.ResultSet[RolePrincipalKey], error)
+	// GetRole get role by id.
+	GetRole(roleID string) (*Role, error)
+	// GetRoleMembers gets users of the role.
+	GetRoleMembers(roleID string, opts ...filters.Option) (*response.ResultSet[User], error)
+	// GetRoles get roles.
+	GetRoles(opts ...filters.Option) (*response.ResultSet[Role], error)
+	// GetSource get source by id.
+	GetSource(sourceID string) (*Source, error)
+	// GetSources get sources.
+	GetSources() (*response.ResultSet[Source], error)
+	// GetUser get user by id.
+	GetUser(userID string) (*User, error)
+	// GetUserAuthorizedKey get user authorized key by id.
+	GetUserAuthorizedKey(userID string, keyID string) (*AuthorizedKey, error)
+	// GetUserRoles get roles of user by id.
+	GetUserRoles(userID string) (*response.ResultSet[Role], error)
+	// GetUserSettings get user settings.
+	GetUserSettings(userID string) (*UserSettings, error)
+	// GetUsersAuthorizedKeys get users authorized keys.
+	GetUsersAuthorizedKeys(userID string, opts ...filters.Option) (*response.ResultSet[AuthorizedKey], error)
+	// ImportPrincipalKey import principal key for role.
+	ImportPrincipalKey(roleID string, key RolePrincipalKeyImport) (response.Identifier, error)
+	// RefreshSources refresh sources.
+	RefreshSources(sourceIDs []string) error
+	// ResolveAuthorizedKey resolve authorized key.
+	ResolveAuthorizedKey(resolve AuthorizedKeyResolve) (*AuthorizedKey, error)
+	// ResolveRoles resolve role names to role.
+	ResolveRoles(names []string) (*response.ResultSet[Role], error)
+	// ResolveUserRoles resolve user roles.
+	ResolveUserRoles(userID string) (*User, error)
+	// SearchExternalUsers search external users.
+	SearchExternalUsers(search UserSearch) (*response.ResultSet[User], error)
+	// SearchIdentityProviders search identity providers.
+	SearchIdentityProviders(search IdentityProviderSearch, opts ...filters.Option) (*response.ResultSet[IdentityProvider], error)
+	// SearchRoles search roles.
+	SearchRoles(search RoleSearch, opts ...filters.Option) (*response.ResultSet[Role], error)
+	// SearchUsers search users.
+	SearchUsers(search UserSearch, opts ...filters.Option) (*response.ResultSet[User], error)
+	// SetMFA enable, disable or reset mfa authentication.
+	SetMFA(userIDs []string, action MFAAction) error
+	// Status get role store microservice status.
+	Status() (*response.ServiceStatus, error)
+	// UpdateAWSRole update AWS role granting PrivX roles.
+	UpdateAWSRole(awsRoleID string, roles []LinkedPrivXRole) error
+	// UpdateCurrentUserAuthorizedKey update current user authorized key.
+	UpdateCurrentUserAuthorizedKey(keyID string, key *AuthorizedKey) error
+	// UpdateCurrentUserSettings update current user settings.
+	UpdateCurrentUserSettings(settings *UserSettings) error
+	// UpdateIdentityProvider update identity provider.
+	UpdateIdentityProvider(providerID string, provider *IdentityProvider) error
+	// UpdateLogConfCollector update logconf collector.
+	UpdateLogConfCollector(collectorID string, collector *LogConfCollector) error
+	// UpdateRole update role.
+	UpdateRole(roleID string, role *Role) error
+	// UpdateSource update source.
+	UpdateSource(sourceID string, source *Source) error
+	// UpdateUserAuthorizedKey update user authorized key.
+	UpdateUserAuthorizedKey(userID string, keyID string, key *AuthorizedKey) error
+	// UpdateUserRoles update user roles by id.
+	UpdateUserRoles(userID string, roles []Role) error
+	// UpdateUserSettings update specific user's settings
+	UpdateUserSettings(userID string, settings *UserSettings) error
+}
+
+var (
+	_ Service = (*RoleStore)(nil)
+	_ Service = (*Fake)(nil)
+)
+
+// Fake is a fake of RoleStore client. Methods delegate to the corresponding
+// function fields, undefined functions return an error.
+type Fake struct {
+	CreateCurrentUserAuthorizedKeyFunc func(*AuthorizedKey) (response.Identifier, error)
+	CreateIdentityProviderFunc         func(*IdentityProvider) (response.Identifier, error)
+	CreateLogConfCollectorFunc         func(*LogConfCollector) (response.Identifier, error)
+	CreatePrincipalKeyFunc             func(string) (response.Identifier, error)
+	CreateRoleFunc                     func(*Role) (response.Identifier, error)
+	CreateSourceFunc                   func(*Source) (response.Identifier, error)
+	CreateUserAuthorizedKeyFunc        func(string, *AuthorizedKey) (response.Identifier, error)
+	DeleteAWSRoleFunc                  func(string) error
+	DeleteCurrentUserAuthorizedKeyFunc func(string) error
+	DeleteIdentityProviderFunc         func(string) error
+	DeleteLogConfCollectorFunc         func(string) error
+	DeletePrincipalKeyFunc             func(string, string) error
+	DeleteRoleFunc                     func(string) error
+	DeleteSourceFunc                   func(string) error
+	DeleteUserAuthorizedKeyFunc        func(string, string) error
+	EvaluateRoleFunc                   func(*Role) (*response.ResultSet[User], error)
+	GetAWSRoleFunc                     func(string) (*AWSRole, error)
+	GetAWSRolesFunc                    func(...filters.Option) (*response.ResultSet[AWSRole], error)
+	GetAWSTokenFunc                    func(string, ...filters.Option) (*AWSToken, error)
+	GetAuthorizedKeysFunc              func(...filters.Option) (*response.ResultSet[AuthorizedKey], error)
+	GetCurrentAWSRolesFunc             func() (*response.ResultSet[AWSRole], error)
+	GetCurrentUserAuthorizedKeyFunc    func(string) (*AuthorizedKey, error)
+	GetCurrentUserAuthorizedKeysFunc   func(...filters.Option) (*response.ResultSet[AuthorizedKey], error)
+	GetCurrentUserInfoFunc             func() (*CurrentUser, error)
+	GetCurrentUserSettingsFunc         func() (*UserSettings, error)
+	GetIdentityProviderFunc            func(string) (*IdentityProvider, error)
+	GetIdentityProvidersFunc           func(...filters.Option) (*response.ResultSet[IdentityProvider], error)
+	GetLinkedRolesFunc                 func(string) (*response.ResultSet[LinkedPrivXRole], error)
+	GetLogConfCollectorFunc            func(string) (*LogConfCollector, error)
+	GetLogConfCollectorsFunc           func() (*response.ResultSet[LogConfCollector], error)
+	GetPrincipalKeyFunc                func(string, string) (RolePrincipalKey, error)
+	GetPrincipalKeysFunc               func(string) (*response.ResultSet[RolePrincipalKey], error)
+	GetRoleFunc                        func(string) (*Role, error)
+	GetRoleMembersFunc                 func(string, ...filters.Option) (*response.ResultSet[User], error)
+	GetRolesFunc                       func(...filters.Option) (*response.ResultSet[Role], error)
+	GetSourceFunc                      func(string) (*Source, error)
+	GetSourcesFunc                     func() (*response.ResultSet[Source], error)
+	GetUserFunc                        func(string) (*User, error)
+	GetUserAuthorizedKeyFunc           func(string, string) (*AuthorizedKey, error)
+	GetUserRolesFunc                   func(string) (*response.ResultSet[Role], error)
+	GetUserSettingsFunc                func(string) (*UserSettings, error)
+	GetUsersAuthorizedKeysFunc         func(string, ...filters.Option) (*response.ResultSet[AuthorizedKey], error)
+	ImportPrincipalKeyFunc             func(string, RolePrincipalKeyImport) (response.Identifier, error)
+	RefreshSourcesFunc                 func([]string) error
+	ResolveAuthorizedKeyFunc           func(AuthorizedKeyResolve) (*AuthorizedKey, error)
+	ResolveRolesFunc                   func([]string) (*response.ResultSet[Role], error)
+	ResolveUserRolesFunc               func(string) (*User, error)
+	SearchExternalUsersFunc            func(UserSearch) (*response.ResultSet[User], error)
+	SearchIdentityProvidersFunc        func(IdentityProviderSearch, ...filters.Option) (*response.ResultSet[IdentityProvider], error)
+	SearchRolesFunc                    func(RoleSearch, ...filters.Option) (*response.ResultSet[Role], error)
+	SearchUsersFunc                    func(UserSearch, ...filters.Option) (*response.ResultSet[User], error)
+	SetMFAFunc                         func([]string, MFAAction) error
+	StatusFunc                         func() (*response.ServiceStatus, error)
+	UpdateAWSRoleFunc                  func(string, []LinkedPrivXRole) error
+	UpdateCurrentUserAuthorizedKeyFunc func(string, *AuthorizedKey) error
+	UpdateCurrentUserSettingsFunc      func(*UserSettings) error
+	UpdateIdentityProviderFunc         func(string, *IdentityProvider) error
+	UpdateLogConfCollectorFunc         func(string, *LogConfCollector) error
+	UpdateRoleFunc                     func(string, *Role) error
+	UpdateSourceFunc                   func(string, *Source) error
+	UpdateUserAuthorizedKeyFunc        func(string, string, *AuthorizedKey) error
+	UpdateUserRolesFunc                func(string, []Role) error
+	UpdateUserSettingsFunc             func(string, *UserSettings) error
+}
+
+// CreateCurrentUserAuthorizedKey calls CreateCurrentUserAuthorizedKeyFunc.
+func (f *Fake) CreateCurrentUserAuthorizedKey(key *AuthorizedKey) (response.Identifier, error) {
+	if f.CreateCurrentUserAuthorizedKeyFunc == nil {
+		var r0 response.Identifier
+		return r0, errors.New("rolestore: Fake.CreateCurrentUserAuthorizedKey is not implemented")
+	}
+	return f.CreateCurrentUserAuthorizedKeyFunc(key)
+}
+
+// CreateIdentityProvider calls CreateIdentityProviderFunc.
+func (f *Fake) CreateIdentityProvider(provider *IdentityProvider) (response.Identifier, error) {
+	if f.CreateIdentityProviderFunc == nil {
+		var r0 response.Identifier
+		return r0, errors.New("rolestore: Fake.CreateIdentityProvider is not implemented")
+	}
+	return f.CreateIdentityProviderFunc(provider)
+}
+
+// CreateLogConfCollector calls CreateLogConfCollectorFunc.
+func (f *Fake) CreateLogConfCollector(collector *LogConfCollector) (response.Identifier, error) {
+	if f.CreateLogConfCollectorFunc == nil {
+		var r0 response.Identifier
+		return r0, errors.New("rolestore: Fake.CreateLogConfCollector is not implemented")
+	}
+	return f.CreateLogConfCollectorFunc(collector)
+}
+
+// CreatePrincipalKey calls CreatePrincipalKeyFunc.
+func (f *Fake) CreatePrincipalKey(roleID string) (response.Identifier, error) {
+	if f.CreatePrincipalKeyFunc == nil {
+		var r0 response.Identifier
+		return r0, errors.New("rolestore: Fake.CreatePrincipalKey is not implemented")
+	}
+	return f.CreatePrincipalKeyFunc(roleID)
+}
+
+// CreateRole calls CreateRoleFunc.
+func (f *Fake) CreateRole(role *Role) (response.Identifier, error) {
+	if f.CreateRoleFunc == nil {
+		var r0 response.Identifier
+		return r0, errors.New("rolestore: Fake.CreateRole is not implemented")
+	}
+	return f.CreateRoleFunc(role)
+}
+
+// CreateSource calls CreateSourceFunc.
+func (f *Fake) CreateSource(source *Source) (response.Identifier, error) {
+	if f.CreateSourceFunc == nil {
+		var r0 response.Identifier
+		return r0, errors.New("rolestore: Fake.CreateSource is not implemented")
+	}
+	return f.CreateSourceFunc(source)
+}
+
+// CreateUserAuthorizedKey calls CreateUserAuthorizedKeyFunc.
+func (f *Fake) CreateUserAuthorizedKey(userID string, key *AuthorizedKey) (response.Identifier, error) {
+	if f.CreateUserAuthorizedKeyFunc == nil {
+		var r0 response.Identifier
+		return r0, errors.New("rolestore: Fake.CreateUserAuthorizedKey is not implemented")
+	}
+	return f.CreateUserAuthorizedKeyFunc(userID, key)
+}
+
+// DeleteAWSRole calls DeleteAWSRoleFunc.
+func (f *Fake) DeleteAWSRole(awsRoleID string) error {
+	if f.DeleteAWSRoleFunc == nil {
+		return errors.New("rolestore: Fake.DeleteAWSRole is not implemented")
+	}
+	return f.DeleteAWSRoleFunc(awsRoleID)
+}
+
+// DeleteCurrentUserAuthorizedKey calls DeleteCurrentUserAuthorizedKeyFunc.
+func (f *Fake) DeleteCurrentUserAuthorizedKey(keyID string) error {
+	if f.DeleteCurrentUserAuthorizedKeyFunc == nil {
+		return errors.New("rolestore: Fake.DeleteCurrentUserAuthorizedKey is not implemented")
+	}
+	return f.DeleteCurrentUserAuthorizedKeyFunc(keyID)
+}
+
+// DeleteIdentityProvider calls DeleteIdentityProviderFunc.
+func (f *Fake) DeleteIdentityProvider(providerID string) error {
+	if f.DeleteIdentityProviderFunc == nil {
+		return errors.New("rolestore: Fake.DeleteIdentityProvider is not implemented")
+	}
+	return f.DeleteIdentityProviderFunc(providerID)
+}
+
+// DeleteLogConfCollector calls DeleteLogConfCollectorFunc.
+func (f *Fake) DeleteLogConfCollector(collectorID string) error {
+	if f.DeleteLogConfCollectorFunc == nil {
+		return errors.New("rolestore: Fake.DeleteLogConfCollector is not implemented")
+	}
+	return f.DeleteLogConfCollectorFunc(collectorID)
+}
+
+// DeletePrincipalKey calls DeletePrincipalKeyFunc.
+func (f *Fake) DeletePrincipalKey(roleID string, keyID string) error {
+	if f.DeletePrincipalKeyFunc == nil {
+		return errors.New("rolestore: Fake.DeletePrincipalKey is not implemented")
+	}
+	return f.DeletePrincipalKeyFunc(roleID, keyID)
+}
+
+// DeleteRole calls DeleteRoleFunc.
+func (f *Fake) DeleteRole(roleID string) error {
+	if f.DeleteRoleFunc == nil {
+		return errors.New("rolestore: Fake.DeleteRole is not implemented")
+	}
+	return f.DeleteRoleFunc(roleID)
+}
+
+// DeleteSource calls DeleteSourceFunc.
+func (f *Fake) DeleteSource(sourceID string) error {
+	if f.DeleteSourceFunc == nil {
+		return errors.New("rolestore: Fake.DeleteSource is not implemented")
+	}
+	return f.DeleteSourceFunc(sourceID)
+}
+
+// DeleteUserAuthorizedKey calls DeleteUserAuthorizedKeyFunc.
+func (f *Fake) DeleteUserAuthorizedKey(userID string, keyID string) error {
+	if f.DeleteUserAuthorizedKeyFunc == nil {
+		return errors.New("rolestore: Fake.DeleteUserAuthorizedKey is not implemented")
+	}
+	return f.DeleteUserAuthorizedKeyFunc(userID, keyID)
+}
+
+// EvaluateRole calls EvaluateRoleFunc.
+func (f *Fake) EvaluateRole(role *Role) (*response.ResultSet[User], error) {
+	if f.EvaluateRoleFunc == nil {
+		var r0 *response.ResultSet[User]
+		return r0, errors.New("rolestore: Fake.EvaluateRole is not implemented")
+	}
+	return f.EvaluateRoleFunc(role)
+}
+
+// GetAWSRole calls GetAWSRoleFunc.
+func (f *Fake) GetAWSRole(awsRoleID string) (*AWSRole, error) {
+	if f.GetAWSRoleFunc == nil {
+		var r0 *AWSRole
+		return r0, errors.New("rolestore: Fake.GetAWSRole is not implemented")
+	}
+	return f.GetAWSRoleFunc(awsRoleID)
+}
+
+// GetAWSRoles calls GetAWSRolesFunc.
+func (f *Fake) GetAWSRoles(opts ...filters.Option) (*response.ResultSet[AWSRole], error) {
+	if f.GetAWSRolesFunc == nil {
+		var r0 *response.ResultSet[AWSRole]
+		return r0, errors.New("rolestore: Fake.GetAWSRoles is not implemented")
+	}
+	return f.GetAWSRolesFunc(opts...)
+}
+
+// GetAWSToken calls GetAWSTokenFunc.
+func (f *Fake) GetAWSToken(roleID string, opts ...filters.Option) (*AWSToken, error) {
+	if f.GetAWSTokenFunc == nil {
+		var r0 *AWSToken
+		return r0, errors.New("rolestore: Fake.GetAWSToken is not implemented")
+	}
+	return f.GetAWSTokenFunc(roleID, opts...)
+}
+
+// GetAuthorizedKeys calls GetAuthorizedKeysFunc.
+func (f *Fake) GetAuthorizedKeys(opts ...filters.Option) (*response.ResultSet[AuthorizedKey], error) {
+	if f.GetAuthorizedKeysFunc == nil {
+		var r0 *response.ResultSet[AuthorizedKey]
+		return r0, errors.New("rolestore: Fake.GetAuthorizedKeys is not implemented")
+	}
+	return f.GetAuthorizedKeysFunc(opts...)
+}
+
+// GetCurrentAWSRoles calls GetCurrentAWSRolesFunc.
+func (f *Fake) GetCurrentAWSRoles() (*response.ResultSet[AWSRole], error) {
+	if f.GetCurrentAWSRolesFunc == nil {
+		var r0 *response.ResultSet[AWSRole]
+		return r0, errors.New("rolestore: Fake.GetCurrentAWSRoles is not implemented")
+	}
+	return f.GetCurrentAWSRolesFunc()
+}
+
+// GetCurrentUserAuthorizedKey calls GetCurrentUserAuthorizedKeyFunc.
+func (f *Fake) GetCurrentUserAuthorizedKey(keyID string) (*AuthorizedKey, error) {
+	if f.GetCurrentUserAuthorizedKeyFunc == nil {
+		var r0 *AuthorizedKey
+		return r0, errors.New("rolestore: Fake.GetCurrentUserAuthorizedKey is not implemented")
+	}
+	return f.GetCurrentUserAuthorizedKeyFunc(keyID)
+}
+
+// GetCurrentUserAuthorizedKeys calls GetCurrentUserAuthorizedKeysFunc.
+func (f *Fake) GetCurrentUserAuthorizedKeys(opts ...filters.Option) (*response.ResultSet[AuthorizedKey], error) {
+	if f.GetCurrentUserAuthorizedKeysFunc == nil {
+		var r0 *response.ResultSet[AuthorizedKey]
+		return r0, errors.New("rolestore: Fake.GetCurrentUserAuthorizedKeys is not implemented")
+	}
+	return f.GetCurrentUserAuthorizedKeysFunc(opts...)
+}
+
+// GetCurrentUserInfo calls GetCurrentUserInfoFunc.
+func (f *Fake) GetCurrentUserInfo() (*CurrentUser, error) {
+	if f.GetCurrentUserInfoFunc == nil {
+		var r0 *CurrentUser
+		return r0, errors.New("rolestore: Fake.GetCurrentUserInfo is not implemented")
+	}
+	return f.GetCurrentUserInfoFunc()
+}
+
+// GetCurrentUserSettings calls GetCurrentUserSettingsFunc.
+func (f *Fake) GetCurrentUserSettings() (*UserSettings, error) {
+	if f.GetCurrentUserSettingsFunc == nil {
+		var r0 *UserSettings
+		return r0, errors.New("rolestore: Fake.GetCurrentUserSettings is not implemented")
+	}
+	return f.GetCurrentUserSettingsFunc()
+}
+
+// GetIdentityProvider calls GetIdentityProviderFunc.
+func (f *Fake) GetIdentityProvider(providerID string) (*IdentityProvider, error) {
+	if f.GetIdentityProviderFunc == nil {
+		var r0 *IdentityProvider
+		return r0, errors.New("rolestore: Fake.GetIdentityProvider is not implemented")
+	}
+	return f.GetIdentityProviderFunc(providerID)
+}
+
+// GetIdentityProviders calls GetIdentityProvidersFunc.
+func (f *Fake) GetIdentityProviders(opts ...filters.Option) (*response.ResultSet[IdentityProvider], error) {
+	if f.GetIdentityProvidersFunc == nil {
+		var r0 *response.ResultSet[IdentityProvider]
+		return r0, errors.New("rolestore: Fake.GetIdentityProviders is not implemented")
+	}
+	return f.GetIdentityProvidersFunc(opts...)
+}
+
+// GetLinkedRoles calls GetLinkedRolesFunc.
+func (f *Fake) GetLinkedRoles(awsRoleID string) (*response.ResultSet[LinkedPrivXRole], error) {
+	if f.GetLinkedRolesFunc == nil {
+		var r0 *response.ResultSet[LinkedPrivXRole]
+		return r0, errors.New("rolestore: Fake.GetLinkedRoles is not implemented")
+	}
+	return f.GetLinkedRolesFunc(awsRoleID)
+}
+
+// GetLogConfCollector calls GetLogConfCollectorFunc.
+func (f *Fake) GetLogConfCollector(collectorID string) (*LogConfCollector, error) {
+	if f.GetLogConfCollectorFunc == nil {
+		var r0 *LogConfCollector
+		return r0, errors.New("rolestore: Fake.GetLogConfCollector is not implemented")
+	}
+	return f.GetLogConfCollectorFunc(collectorID)
+}
+
+// GetLogConfCollectors calls GetLogConfCollectorsFunc.
+func (f *Fake) GetLogConfCollectors() (*response.ResultSet[LogConfCollector], error) {
+	if f.GetLogConfCollectorsFunc == nil {
+		var r0 *response.ResultSet[LogConfCollector]
+		return r0, errors.New("rolestore: Fake.GetLogConfCollectors is not implemented")
+	}
+	return f.GetLogConfCollectorsFunc()
+}
+
+// GetPrincipalKey calls GetPrincipalKeyFunc.
+func (f *Fake) GetPrincipalKey(roleID string, keyID string) (RolePrincipalKey, error) {
+	if f.GetPrincipalKeyFunc == nil {
+		var r0 RolePrincipalKey
+		return r0, errors.New("rolestore: Fake.GetPrincipalKey is not implemented")
+	}
+	return f.GetPrincipalKeyFunc(roleID, keyID)
+}
+
+// GetPrincipalKeys calls GetPrincipalKeysFunc.
+func (f *Fake) GetPrincipalKeys(roleID string) (*response.ResultSet[RolePrincipalKey], error) {
+	if f.GetPrincipalKeysFunc == nil {
+		var r0 *response.ResultSet[RolePrincipalKey]
+		return r0, errors.New("rolestore: Fake.GetPrincipalKeys is not implemented")
+	}
+	return f.GetPrincipalKeysFunc(roleID)
+}
+
+// GetRole calls GetRoleFunc.
+func (f *Fake) GetRole(roleID string) (*Role, error) {
+	if f.GetRoleFunc == nil {
+		var r0 *Role
+		return r0, errors.New("rolestore: Fake.GetRole is not implemented")
+	}
+	return f.GetRoleFunc(roleID)
+}
+
+// GetRoleMembers calls GetRoleMembersFunc.
+func (f *Fake) GetRoleMembers(roleID string, opts ...filters.Option) (*response.ResultSet[User], error) {
+	if f.GetRoleMembersFunc == nil {
+		var r0 *response.ResultSet[User]
+		return r0, errors.New("rolestore: Fake.GetRoleMembers is not implemented")
+	}
+	return f.GetRoleMembersFunc(roleID, opts...)
+}
+
+// GetRoles calls GetRolesFunc.
+func (f *Fake) GetRoles(opts ...filters.Option) (*response.ResultSet[Role], error) {
+	if f.GetRolesFunc == nil {
+		var r0 *response.ResultSet[Role]
+		return r0, errors.New("rolestore: Fake.GetRoles is not implemented")
+	}
+	return f.GetRolesFunc(opts...)
+}
+
+// GetSource calls GetSourceFunc.
+func (f *Fake) GetSource(sourceID string) (*Source, error) {
+	if f.GetSourceFunc == nil {
+		var r0 *Source
+		return r0, errors.New("rolestore: Fake.GetSource is not implemented")
+	}
+	return f.GetSourceFunc(sourceID)
+}
+
+// GetSources calls GetSourcesFunc.
+func (f *Fake) GetSources() (*response.ResultSet[Source], error) {
+	if f.GetSourcesFunc == nil {
+		var r0 *response.ResultSet[Source]
+		return r0, errors.New("rolestore: Fake.GetSources is not implemented")
+	}
+	return f.GetSourcesFunc()
+}
+
+// GetUser calls GetUserFunc.
+func (f *Fake) GetUser(userID string) (*User, error) {
+	if f.GetUserFunc == nil {
+		var r0 *User
+		return r0, errors.New("rolestore: Fake.GetUser is not implemented")
+	}
+	return f.GetUserFunc(userID)
+}
+
+// GetUserAuthorizedKey calls GetUserAuthorizedKeyFunc.
+func (f *Fake) GetUserAuthorizedKey(userID string, keyID string) (*AuthorizedKey, error) {
+	if f.GetUserAuthorizedKeyFunc == nil {
+		var r0 *AuthorizedKey
+		return r0, errors.New("rolestore: Fake.GetUserAuthorizedKey is not implemented")
+	}
+	return f.GetUserAuthorizedKeyFunc(userID, keyID)
+}
+
+// GetUserRoles calls GetUserRolesFunc.
+func (f *Fake) GetUserRoles(userID string) (*response.ResultSet[Role], error) {
+	if f.GetUserRolesFunc == nil {
+		var r0 *response.ResultSet[Role]
+		return r0, errors.New("rolestore: Fake.GetUserRoles is not implemented")
+	}
+	return f.GetUserRolesFunc(userID)
+}
+
+// GetUserSettings calls GetUserSettingsFunc.
+func (f *Fake) GetUserSettings(userID string) (*UserSettings, error) {
+	if f.GetUserSettingsFunc == nil {
+		var r0 *UserSettings
+		return r0, errors.New("rolestore: Fake.GetUserSettings is not implemented")
+	}
+	return f.GetUserSettingsFunc(userID)
+}
+
+// GetUsersAuthorizedKeys calls GetUsersAuthorizedKeysFunc.
+func (f *Fake) GetUsersAuthorizedKeys(userID string, opts ...filters.Option) (*response.ResultSet[AuthorizedKey], error) {
+	if f.GetUsersAuthorizedKeysFunc == nil {
+		var r0 *response.ResultSet[AuthorizedKey]
+		return r0, errors.New("rolestore: Fake.GetUsersAuthorizedKeys is not implemented")
+	}
+	return f.GetUsersAuthorizedKeysFunc(userID, opts...)
+}
+
+// ImportPrincipalKey calls ImportPrincipalKeyFunc.
+func (f *Fake) ImportPrincipalKey(roleID string, key RolePrincipalKeyImport) (response.Identifier, error) {
+	if f.ImportPrincipalKeyFunc == nil {
+		var r0 response.Identifier
+		return r0, errors.New("rolestore: Fake.ImportPrincipalKey is not implemented")
+	}
+	return f.ImportPrincipalKeyFunc(roleID, key)
+}
+
+// RefreshSources calls RefreshSourcesFunc.
+func (f *Fake) RefreshSources(sourceIDs []string) error {
+	if f.RefreshSourcesFunc == nil {
+		return errors.New("rolestore: Fake.RefreshSources is not implemented")
+	}
+	return f.RefreshSourcesFunc(sourceIDs)
+}
+
+// ResolveAuthorizedKey calls ResolveAuthorizedKeyFunc.
+func (f *Fake) ResolveAuthorizedKey(resolve AuthorizedKeyResolve) (*AuthorizedKey, error) {
+	if f.ResolveAuthorizedKeyFunc == nil {
+		var r0 *AuthorizedKey
+		return r0, errors.New("rolestore: Fake.ResolveAuthorizedKey is not implemented")
+	}
+	return f.ResolveAuthorizedKeyFunc(resolve)
+}
+
+// ResolveRoles calls ResolveRolesFunc.
+func (f *Fake) ResolveRoles(names []string) (*response.ResultSet[Role], error) {
+	if f.ResolveRolesFunc == nil {
+		var r0 *response.ResultSet[Role]
+		return r0, errors.New("rolestore: Fake.ResolveRoles is not implemented")
+	}
+	return f.ResolveRolesFunc(names)
+}
+
+// ResolveUserRoles calls ResolveUserRolesFunc.
+func (f *Fake) ResolveUserRoles(userID string) (*User, error) {
+	if f.ResolveUserRolesFunc == nil {
+		var r0 *User
+		return r0, errors.New("rolestore: Fake.ResolveUserRoles is not implemented")
+	}
+	return f.ResolveUserRolesFunc(userID)
+}
+
+// SearchExternalUsers calls SearchExternalUsersFunc.
+func (f *Fake) SearchExternalUsers(search UserSearch) (*response.ResultSet[User], error) {
+	if f.SearchExternalUsersFunc == nil {
+		var r0 *response.ResultSet[User]
+		return r0, errors.New("rolestore: Fake.SearchExternalUsers is not implemented")
+	}
+	return f.SearchExternalUsersFunc(search)
+}
+
+// SearchIdentityProviders calls SearchIdentityProvidersFunc.
+func (f *Fake) SearchIdentityProviders(search IdentityProviderSearch, opts ...filters.Option) (*response.ResultSet[IdentityProvider], error) {
+	if f.SearchIdentityProvidersFunc == nil {
+		var r0 *response.ResultSet[IdentityProvider]
+		return r0, errors.New("rolestore: Fake.SearchIdentityProviders is not implemented")
+	}
+	return f.SearchIdentityProvidersFunc(search, opts...)
+}
+
+// SearchRoles calls SearchRolesFunc.
+func (f *Fake) SearchRoles(search RoleSearch, opts ...filters.Option) (*response.ResultSet[Role], error) {
+	if f.SearchRolesFunc == nil {
+		var r0 *response.ResultSet[Role]
+		return r0, errors.New("rolestore: Fake.SearchRoles is not implemented")
+	}
+	return f.SearchRolesFunc(search, opts...)
+}
+
+// SearchUsers calls SearchUsersFunc.
+func (f *Fake) SearchUsers(search UserSearch, opts ...filters.Option) (*response.ResultSet[User], error) {
+	if f.SearchUsersFunc == nil {
+		var r0 *response.ResultSet[User]
+		return r0, errors.New("rolestore: Fake.SearchUsers is not implemented")
+	}
+	return f.SearchUsersFunc(search, opts...)
+}
+
+// SetMFA calls SetMFAFunc.
+func (f *Fake) SetMFA(userIDs []string, action MFAAction) error {
+	if f.SetMFAFunc == nil {
+		return errors.New("rolestore: Fake.SetMFA is not implemented")
+	}
+	return f.SetMFAFunc(userIDs, action)
+}
+
+// Status calls StatusFunc.
+func (f *Fake) Status() (*response.ServiceStatus, error) {
+	if f.StatusFunc == nil {
+		var r0 *response.ServiceStatus
+		return r0, errors.New("rolestore: Fake.Status is not implemented")
+	}
+	return f.StatusFunc()
+}
+
+// UpdateAWSRole calls UpdateAWSRoleFunc.
+func (f *Fake) UpdateAWSRole(awsRoleID string, roles []LinkedPrivXRole) error {
+	if f.UpdateAWSRoleFunc == nil {
+		return errors.New("rolestore: Fake.UpdateAWSRole is not implemented")
+	}
+	return f.UpdateAWSRoleFunc(awsRoleID, roles)
+}
+
+// UpdateCurrentUserAuthorizedKey calls UpdateCurrentUserAuthorizedKeyFunc.
+func (f *Fake) UpdateCurrentUserAuthorizedKey(keyID string, key *AuthorizedKey) error {
+	if f.UpdateCurrentUserAuthorizedKeyFunc == nil {
+		return errors.New("rolestore: Fake.UpdateCurrentUserAuthorizedKey is not implemented")
+	}
+	return f.UpdateCurrentUserAuthorizedKeyFunc(keyID, key)
+}
+
+// UpdateCurrentUserSettings calls UpdateCurrentUserSettingsFunc.
+func (f *Fake) UpdateCurrentUserSettings(settings *UserSettings) error {
+	if f.UpdateCurrentUserSettingsFunc == nil {
+		return errors.New("rolestore: Fake.UpdateCurrentUserSettings is not implemented")
+	}
+	return f.UpdateCurrentUserSettingsFunc(settings)
+}
+
+// UpdateIdentityProvider calls UpdateIdentityProviderFunc.
+func (f *Fake) UpdateIdentityProvider(providerID string, provider *IdentityProvider) error {
+	if f.UpdateIdentityProviderFunc == nil {
+		return errors.New("rolestore: Fake.UpdateIdentityProvider is not implemented")
+	}
+	return f.UpdateIdentityProviderFunc(providerID, provider)
+}
+
+// UpdateLogConfCollector calls UpdateLogConfCollectorFunc.
+func (f *Fake) UpdateLogConfCollector(collectorID string, collector *LogConfCollector) error {
+	if f.UpdateLogConfCollectorFunc == nil {
+		return errors.New("rolestore: Fake.UpdateLogConfCollector is not implemented")
+	}
+	return f.UpdateLogConfCollectorFunc(collectorID, collector)
+}
+
+// UpdateRole calls UpdateRoleFunc.
+func (f *Fake) UpdateRole(roleID string, role *Role) error {
+	if f.UpdateRoleFunc == nil {
+		return errors.New("rolestore: Fake.UpdateRole is not implemented")
+	}
+	return f.UpdateRoleFunc(roleID, role)
+}
+
+// UpdateSource calls UpdateSourceFunc.
+func (f *Fake) UpdateSource(sourceID string, source *Source) error {
+	if f.UpdateSourceFunc == nil {
+		return errors.New("rolestore: Fake.UpdateSource is not implemented")
+	}
+	return f.UpdateSourceFunc(sourceID, source)
+}
+
+// UpdateUserAuthorizedKey calls UpdateUserAuthorizedKeyFunc.
+func (f *Fake) UpdateUserAuthorizedKey(userID string, keyID string, key *AuthorizedKey) error {
+	if f.UpdateUserAuthorizedKeyFunc == nil {
+		return errors.New("rolestore: Fake.UpdateUserAuthorizedKey is not implemented")
+	}
+	return f.UpdateUserAuthorizedKeyFunc(userID, keyID, key)
+}
+
+// UpdateUserRoles calls UpdateUserRolesFunc.
+func (f *Fake) UpdateUserRoles(userID string, roles []Role) error {
+	if f.UpdateUserRolesFunc == nil {
+		return errors.New("rolestore: Fake.UpdateUserRoles is not implemented")
+	}
+	return f.UpdateUserRolesFunc(userID, roles)
+}
+
+// UpdateUserSettings calls UpdateUserSettingsFunc.
+func (f *Fake) UpdateUserSettings(userID string, settings *UserSettings) error {
+	if f.UpdateUserSettingsFunc == nil {
+		return errors.New("rolestore: Fake.UpdateUserSettings is not implemented")
+	}
+	return f.UpdateUserSettingsFunc(userID, settings)
+}
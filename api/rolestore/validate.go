@@ -0,0 +1,35 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package rolestore
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Validate checks required fields and enumerated values of role.
+func (r *Role) Validate() error {
+	if strings.TrimSpace(r.Name) == "" {
+		return fmt.Errorf("invalid role: name is required")
+	}
+
+	switch r.GrantType {
+	case "", GrantPermanent:
+	case GrantTimeRestricted:
+		if r.GrantStart.IsZero() && r.GrantEnd.IsZero() && len(r.GrantValidityPeriods) == 0 {
+			return fmt.Errorf("invalid role: time restricted grant requires validity period")
+		}
+	case GrantFloating:
+		if r.FloatingLength <= 0 {
+			return fmt.Errorf("invalid role: floating grant requires floating length")
+		}
+	default:
+		return fmt.Errorf("invalid role: unknown grant type %q", r.GrantType)
+	}
+
+	return nil
+}
@@ -1,5 +1,7 @@
 package secretsmanager
 
+//go:generate go run github.com/SSHcom/privx-sdk-go/v2/internal/mockgen
+
 import (
 	"net/url"
 
@@ -193,8 +195,8 @@ func (c *SecretsManager) GetTargetDomains(opts ...filters.Option) (*response.Res
 	tds := &response.ResultSet[TargetDomain]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -221,8 +223,8 @@ func (c *SecretsManager) SearchTargetDomain(search TargetDomainsSearch, opts ...
 	tds := &response.ResultSet[TargetDomain]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -288,8 +290,8 @@ func (c *SecretsManager) GetTargetDomainAccounts(tdID string, opts ...filters.Op
 	accounts := &response.ResultSet[ScannedAccount]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -305,8 +307,8 @@ func (c *SecretsManager) SearchTargetDomainAccounts(tdID string, search ScannedA
 	accounts := &response.ResultSet[ScannedAccount]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -352,8 +354,8 @@ func (c *SecretsManager) GetManagedAccounts(tdID string, opts ...filters.Option)
 	accounts := &response.ResultSet[ManagedAccount]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -380,8 +382,8 @@ func (c *SecretsManager) SearchManagedAccounts(tdID string, search ManagedAccoun
 	accounts := &response.ResultSet[ManagedAccount]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -0,0 +1,499 @@
+// Code generated by mockgen from SecretsManager method set; DO NOT EDIT.
+
+package secretsmanager
+
+import (
+	"errors"
+
+	"github.com/SSHcom/privx-sdk-go/v2/api/filters"
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
+)
+
+// Service is the interface of SecretsManager client, it allows substitution of
+// the client in tests, see Fake.
+type Service interface {
+	// BatchCreateManagedAccount create a batch of managed accounts.
+	BatchCreateManagedAccount(tdID string, create ManagedAccountCreateBatch) (IDList, error)
+	// BatchDeleteManagedAccount delete a batch of managed accounts.
+	BatchDeleteManagedAccount(tdID string, delete ManagedAccountDeleteBatch) error
+	// BatchRotateManagedAccount rotate a batch of managed accounts.
+	BatchRotateManagedAccount(tdID string, rotate ManagedAccountRotateBatch) error
+	// BatchUpdateManagedAccount update a batch of managed accounts.
+	BatchUpdateManagedAccount(tdID string, change *ManagedAccountEditBatch) error
+	// BatchUpdateTargetDomain update target domain in batch.
+	BatchUpdateTargetDomain(tdID string, edit ScannedAccountEditBatch) error
+	// CompileScript compile script with test data.
+	CompileScript(compile CompileScript) (CompileScriptResponse, error)
+	// CreateHostSecret create host secret.
+	CreateHostSecret(hostID string, secret *HostSecretMetadata) (*HostSecretMetadata, error)
+	// CreateManagedAccount create a managed account.
+	CreateManagedAccount(tdID string, account *ManagedAccount) (response.Identifier, error)
+	// CreatePasswordPolicy create password policy.
+	CreatePasswordPolicy(policy *PasswordPolicy) (response.Identifier, error)
+	// CreateScriptTemplate create script template.
+	CreateScriptTemplate(template *ScriptTemplate) (response.Identifier, error)
+	// CreateTargetDomain create target domain.
+	CreateTargetDomain(td *TargetDomain) (response.Identifier, error)
+	// DeleteHostSecret delete host secret.
+	DeleteHostSecret(hostID string) error
+	// DeleteManagedAccount delete managed account.
+	DeleteManagedAccount(tdID string, maID string) error
+	// DeletePasswordPolicy delete password policy.
+	DeletePasswordPolicy(policyID string) error
+	// DeleteScriptTemplate delete script template.
+	DeleteScriptTemplate(templateID string) error
+	// DeleteTargetDomain delete target domain.
+	DeleteTargetDomain(tdID string) error
+	// GetHostSecretMetadata get host secret metadata for all accounts.
+	GetHostSecretMetadata(hostID string) (*HostSecretMetadata, error)
+	// GetManagedAccount get managed account in target domain by id.
+	GetManagedAccount(tdID string, maID string) (*ManagedAccount, error)
+	// GetManagedAccounts get managed accounts in a target domain.
+	GetManagedAccounts(tdID string, opts ...filters.Option) (*response.ResultSet[ManagedAccount], error)
+	// GetPasswordPolicies get password policies.
+	GetPasswordPolicies() (*response.ResultSet[PasswordPolicy], error)
+	// GetPasswordPolicy get password policy by id.
+	GetPasswordPolicy(policyID string) (*PasswordPolicy, error)
+	// GetScriptTemplate get script template by id.
+	GetScriptTemplate(templateID string) (*ScriptTemplate, error)
+	// GetScriptTemplates get script templates.
+	GetScriptTemplates() (*response.ResultSet[ScriptTemplate], error)
+	// GetTargetDomain get target domain by id.
+	GetTargetDomain(tdID string) (*TargetDomain, error)
+	// GetTargetDomainAccount get target domain account by id.
+	GetTargetDomainAccount(tdID string, accountID string) (*ScannedAccount, error)
+	// GetTargetDomainAccounts get accounts in target domain.
+	GetTargetDomainAccounts(tdID string, opts ...filters.Option) (*response.ResultSet[ScannedAccount], error)
+	// GetTargetDomains get target domains.
+	GetTargetDomains(opts ...filters.Option) (*response.ResultSet[TargetDomain], error)
+	// RefreshTargetDomain trigger target domain account scan.
+	RefreshTargetDomain(tdID string) error
+	// ResolveTargetDomains resolve target domain names to target domain IDs.
+	ResolveTargetDomains(tdNames []string) (TargetDomainsResolveResponse, error)
+	// RotateManagedAccountPassword trigger managed account password rotation.
+	RotateManagedAccountPassword(tdID string, maID string) error
+	// RotatePassword initiate password rotation.
+	RotatePassword(hostID string, account string) error
+	// SearchManagedAccounts search managed accounts in a target domain.
+	SearchManagedAccounts(tdID string, search ManagedAccountsSearch, opts ...filters.Option) (*response.ResultSet[ManagedAccount], error)
+	// SearchTargetDomain search target domains.
+	SearchTargetDomain(search TargetDomainsSearch, opts ...filters.Option) (*response.ResultSet[TargetDomain], error)
+	// SearchTargetDomainAccounts search accounts in target domain.
+	SearchTargetDomainAccounts(tdID string, search ScannedAccountsSearch, opts ...filters.Option) (*response.ResultSet[ScannedAccount], error)
+	// SetManagedAccountPassword set password for managed account.
+	SetManagedAccountPassword(tdID ManagedAccountPasswordSet, maID ManagedAccountPasswordSet, password ManagedAccountPasswordSet) error
+	// Status get secrets manager microservice status.
+	Status() (*response.ServiceStatus, error)
+	// UpdatePasswordPolicy update password policy.
+	UpdatePasswordPolicy(policyID string, policy *PasswordPolicy) error
+	// UpdateScriptTemplate update script template.
+	UpdateScriptTemplate(templateID string, template *ScriptTemplate) error
+	// UpdateTargetDomain update target domain.
+	UpdateTargetDomain(tdID string, td *TargetDomain) error
+	// UpdateTargetDomainAccount update target domain account.
+	UpdateTargetDomainAccount(tdID string, accountID string, change ScannedAccountChangeSet) error
+	// UpdateTargetManagedAccount update managed account.
+	UpdateTargetManagedAccount(tdID string, maID string, account *ManagedAccount) error
+}
+
+var (
+	_ Service = (*SecretsManager)(nil)
+	_ Service = (*Fake)(nil)
+)
+
+// Fake is a fake of SecretsManager client. Methods delegate to the corresponding
+// function fields, undefined functions return an error.
+type Fake struct {
+	BatchCreateManagedAccountFunc    func(string, ManagedAccountCreateBatch) (IDList, error)
+	BatchDeleteManagedAccountFunc    func(string, ManagedAccountDeleteBatch) error
+	BatchRotateManagedAccountFunc    func(string, ManagedAccountRotateBatch) error
+	BatchUpdateManagedAccountFunc    func(string, *ManagedAccountEditBatch) error
+	BatchUpdateTargetDomainFunc      func(string, ScannedAccountEditBatch) error
+	CompileScriptFunc                func(CompileScript) (CompileScriptResponse, error)
+	CreateHostSecretFunc             func(string, *HostSecretMetadata) (*HostSecretMetadata, error)
+	CreateManagedAccountFunc         func(string, *ManagedAccount) (response.Identifier, error)
+	CreatePasswordPolicyFunc         func(*PasswordPolicy) (response.Identifier, error)
+	CreateScriptTemplateFunc         func(*ScriptTemplate) (response.Identifier, error)
+	CreateTargetDomainFunc           func(*TargetDomain) (response.Identifier, error)
+	DeleteHostSecretFunc             func(string) error
+	DeleteManagedAccountFunc         func(string, string) error
+	DeletePasswordPolicyFunc         func(string) error
+	DeleteScriptTemplateFunc         func(string) error
+	DeleteTargetDomainFunc           func(string) error
+	GetHostSecretMetadataFunc        func(string) (*HostSecretMetadata, error)
+	GetManagedAccountFunc            func(string, string) (*ManagedAccount, error)
+	GetManagedAccountsFunc           func(string, ...filters.Option) (*response.ResultSet[ManagedAccount], error)
+	GetPasswordPoliciesFunc          func() (*response.ResultSet[PasswordPolicy], error)
+	GetPasswordPolicyFunc            func(string) (*PasswordPolicy, error)
+	GetScriptTemplateFunc            func(string) (*ScriptTemplate, error)
+	GetScriptTemplatesFunc           func() (*response.ResultSet[ScriptTemplate], error)
+	GetTargetDomainFunc              func(string) (*TargetDomain, error)
+	GetTargetDomainAccountFunc       func(string, string) (*ScannedAccount, error)
+	GetTargetDomainAccountsFunc      func(string, ...filters.Option) (*response.ResultSet[ScannedAccount], error)
+	GetTargetDomainsFunc             func(...filters.Option) (*response.ResultSet[TargetDomain], error)
+	RefreshTargetDomainFunc          func(string) error
+	ResolveTargetDomainsFunc         func([]string) (TargetDomainsResolveResponse, error)
+	RotateManagedAccountPasswordFunc func(string, string) error
+	RotatePasswordFunc               func(string, string) error
+	SearchManagedAccountsFunc        func(string, ManagedAccountsSearch, ...filters.Option) (*response.ResultSet[ManagedAccount], error)
+	SearchTargetDomainFunc           func(TargetDomainsSearch, ...filters.Option) (*response.ResultSet[TargetDomain], error)
+	SearchTargetDomainAccountsFunc   func(string, ScannedAccountsSearch, ...filters.Option) (*response.ResultSet[ScannedAccount], error)
+	SetManagedAccountPasswordFunc    func(ManagedAccountPasswordSet, ManagedAccountPasswordSet, ManagedAccountPasswordSet) error
+	StatusFunc                       func() (*response.ServiceStatus, error)
+	UpdatePasswordPolicyFunc         func(string, *PasswordPolicy) error
+	UpdateScriptTemplateFunc         func(string, *ScriptTemplate) error
+	UpdateTargetDomainFunc           func(string, *TargetDomain) error
+	UpdateTargetDomainAccountFunc    func(string, string, ScannedAccountChangeSet) error
+	UpdateTargetManagedAccountFunc   func(string, string, *ManagedAccount) error
+}
+
+// BatchCreateManagedAccount calls BatchCreateManagedAccountFunc.
+func (f *Fake) BatchCreateManagedAccount(tdID string, create ManagedAccountCreateBatch) (IDList, error) {
+	if f.BatchCreateManagedAccountFunc == nil {
+		var r0 IDList
+		return r0, errors.New("secretsmanager: Fake.BatchCreateManagedAccount is not implemented")
+	}
+	return f.BatchCreateManagedAccountFunc(tdID, create)
+}
+
+// BatchDeleteManagedAccount calls BatchDeleteManagedAccountFunc.
+func (f *Fake) BatchDeleteManagedAccount(tdID string, delete ManagedAccountDeleteBatch) error {
+	if f.BatchDeleteManagedAccountFunc == nil {
+		return errors.New("secretsmanager: Fake.BatchDeleteManagedAccount is not implemented")
+	}
+	return f.BatchDeleteManagedAccountFunc(tdID, delete)
+}
+
+// BatchRotateManagedAccount calls BatchRotateManagedAccountFunc.
+func (f *Fake) BatchRotateManagedAccount(tdID string, rotate ManagedAccountRotateBatch) error {
+	if f.BatchRotateManagedAccountFunc == nil {
+		return errors.New("secretsmanager: Fake.BatchRotateManagedAccount is not implemented")
+	}
+	return f.BatchRotateManagedAccountFunc(tdID, rotate)
+}
+
+// BatchUpdateManagedAccount calls BatchUpdateManagedAccountFunc.
+func (f *Fake) BatchUpdateManagedAccount(tdID string, change *ManagedAccountEditBatch) error {
+	if f.BatchUpdateManagedAccountFunc == nil {
+		return errors.New("secretsmanager: Fake.BatchUpdateManagedAccount is not implemented")
+	}
+	return f.BatchUpdateManagedAccountFunc(tdID, change)
+}
+
+// BatchUpdateTargetDomain calls BatchUpdateTargetDomainFunc.
+func (f *Fake) BatchUpdateTargetDomain(tdID string, edit ScannedAccountEditBatch) error {
+	if f.BatchUpdateTargetDomainFunc == nil {
+		return errors.New("secretsmanager: Fake.BatchUpdateTargetDomain is not implemented")
+	}
+	return f.BatchUpdateTargetDomainFunc(tdID, edit)
+}
+
+// CompileScript calls CompileScriptFunc.
+func (f *Fake) CompileScript(compile CompileScript) (CompileScriptResponse, error) {
+	if f.CompileScriptFunc == nil {
+		var r0 CompileScriptResponse
+		return r0, errors.New("secretsmanager: Fake.CompileScript is not implemented")
+	}
+	return f.CompileScriptFunc(compile)
+}
+
+// CreateHostSecret calls CreateHostSecretFunc.
+func (f *Fake) CreateHostSecret(hostID string, secret *HostSecretMetadata) (*HostSecretMetadata, error) {
+	if f.CreateHostSecretFunc == nil {
+		var r0 *HostSecretMetadata
+		return r0, errors.New("secretsmanager: Fake.CreateHostSecret is not implemented")
+	}
+	return f.CreateHostSecretFunc(hostID, secret)
+}
+
+// CreateManagedAccount calls CreateManagedAccountFunc.
+func (f *Fake) CreateManagedAccount(tdID string, account *ManagedAccount) (response.Identifier, error) {
+	if f.CreateManagedAccountFunc == nil {
+		var r0 response.Identifier
+		return r0, errors.New("secretsmanager: Fake.CreateManagedAccount is not implemented")
+	}
+	return f.CreateManagedAccountFunc(tdID, account)
+}
+
+// CreatePasswordPolicy calls CreatePasswordPolicyFunc.
+func (f *Fake) CreatePasswordPolicy(policy *PasswordPolicy) (response.Identifier, error) {
+	if f.CreatePasswordPolicyFunc == nil {
+		var r0 response.Identifier
+		return r0, errors.New("secretsmanager: Fake.CreatePasswordPolicy is not implemented")
+	}
+	return f.CreatePasswordPolicyFunc(policy)
+}
+
+// CreateScriptTemplate calls CreateScriptTemplateFunc.
+func (f *Fake) CreateScriptTemplate(template *ScriptTemplate) (response.Identifier, error) {
+	if f.CreateScriptTemplateFunc == nil {
+		var r0 response.Identifier
+		return r0, errors.New("secretsmanager: Fake.CreateScriptTemplate is not implemented")
+	}
+	return f.CreateScriptTemplateFunc(template)
+}
+
+// CreateTargetDomain calls CreateTargetDomainFunc.
+func (f *Fake) CreateTargetDomain(td *TargetDomain) (response.Identifier, error) {
+	if f.CreateTargetDomainFunc == nil {
+		var r0 response.Identifier
+		return r0, errors.New("secretsmanager: Fake.CreateTargetDomain is not implemented")
+	}
+	return f.CreateTargetDomainFunc(td)
+}
+
+// DeleteHostSecret calls DeleteHostSecretFunc.
+func (f *Fake) DeleteHostSecret(hostID string) error {
+	if f.DeleteHostSecretFunc == nil {
+		return errors.New("secretsmanager: Fake.DeleteHostSecret is not implemented")
+	}
+	return f.DeleteHostSecretFunc(hostID)
+}
+
+// DeleteManagedAccount calls DeleteManagedAccountFunc.
+func (f *Fake) DeleteManagedAccount(tdID string, maID string) error {
+	if f.DeleteManagedAccountFunc == nil {
+		return errors.New("secretsmanager: Fake.DeleteManagedAccount is not implemented")
+	}
+	return f.DeleteManagedAccountFunc(tdID, maID)
+}
+
+// DeletePasswordPolicy calls DeletePasswordPolicyFunc.
+func (f *Fake) DeletePasswordPolicy(policyID string) error {
+	if f.DeletePasswordPolicyFunc == nil {
+		return errors.New("secretsmanager: Fake.DeletePasswordPolicy is not implemented")
+	}
+	return f.DeletePasswordPolicyFunc(policyID)
+}
+
+// DeleteScriptTemplate calls DeleteScriptTemplateFunc.
+func (f *Fake) DeleteScriptTemplate(templateID string) error {
+	if f.DeleteScriptTemplateFunc == nil {
+		return errors.New("secretsmanager: Fake.DeleteScriptTemplate is not implemented")
+	}
+	return f.DeleteScriptTemplateFunc(templateID)
+}
+
+// DeleteTargetDomain calls DeleteTargetDomainFunc.
+func (f *Fake) DeleteTargetDomain(tdID string) error {
+	if f.DeleteTargetDomainFunc == nil {
+		return errors.New("secretsmanager: Fake.DeleteTargetDomain is not implemented")
+	}
+	return f.DeleteTargetDomainFunc(tdID)
+}
+
+// GetHostSecretMetadata calls GetHostSecretMetadataFunc.
+func (f *Fake) GetHostSecretMetadata(hostID string) (*HostSecretMetadata, error) {
+	if f.GetHostSecretMetadataFunc == nil {
+		var r0 *HostSecretMetadata
+		return r0, errors.New("secretsmanager: Fake.GetHostSecretMetadata is not implemented")
+	}
+	return f.GetHostSecretMetadataFunc(hostID)
+}
+
+// GetManagedAccount calls GetManagedAccountFunc.
+func (f *Fake) GetManagedAccount(tdID string, maID string) (*ManagedAccount, error) {
+	if f.GetManagedAccountFunc == nil {
+		var r0 *ManagedAccount
+		return r0, errors.New("secretsmanager: Fake.GetManagedAccount is not implemented")
+	}
+	return f.GetManagedAccountFunc(tdID, maID)
+}
+
+// GetManagedAccounts calls GetManagedAccountsFunc.
+func (f *Fake) GetManagedAccounts(tdID string, opts ...filters.Option) (*response.ResultSet[ManagedAccount], error) {
+	if f.GetManagedAccountsFunc == nil {
+		var r0 *response.ResultSet[ManagedAccount]
+		return r0, errors.New("secretsmanager: Fake.GetManagedAccounts is not implemented")
+	}
+	return f.GetManagedAccountsFunc(tdID, opts...)
+}
+
+// GetPasswordPolicies calls GetPasswordPoliciesFunc.
+func (f *Fake) GetPasswordPolicies() (*response.ResultSet[PasswordPolicy], error) {
+	if f.GetPasswordPoliciesFunc == nil {
+		var r0 *response.ResultSet[PasswordPolicy]
+		return r0, errors.New("secretsmanager: Fake.GetPasswordPolicies is not implemented")
+	}
+	return f.GetPasswordPoliciesFunc()
+}
+
+// GetPasswordPolicy calls GetPasswordPolicyFunc.
+func (f *Fake) GetPasswordPolicy(policyID string) (*PasswordPolicy, error) {
+	if f.GetPasswordPolicyFunc == nil {
+		var r0 *PasswordPolicy
+		return r0, errors.New("secretsmanager: Fake.GetPasswordPolicy is not implemented")
+	}
+	return f.GetPasswordPolicyFunc(policyID)
+}
+
+// GetScriptTemplate calls GetScriptTemplateFunc.
+func (f *Fake) GetScriptTemplate(templateID string) (*ScriptTemplate, error) {
+	if f.GetScriptTemplateFunc == nil {
+		var r0 *ScriptTemplate
+		return r0, errors.New("secretsmanager: Fake.GetScriptTemplate is not implemented")
+	}
+	return f.GetScriptTemplateFunc(templateID)
+}
+
+// GetScriptTemplates calls GetScriptTemplatesFunc.
+func (f *Fake) GetScriptTemplates() (*response.ResultSet[ScriptTemplate], error) {
+	if f.GetScriptTemplatesFunc == nil {
+		var r0 *response.ResultSet[ScriptTemplate]
+		return r0, errors.New("secretsmanager: Fake.GetScriptTemplates is not implemented")
+	}
+	return f.GetScriptTemplatesFunc()
+}
+
+// GetTargetDomain calls GetTargetDomainFunc.
+func (f *Fake) GetTargetDomain(tdID string) (*TargetDomain, error) {
+	if f.GetTargetDomainFunc == nil {
+		var r0 *TargetDomain
+		return r0, errors.New("secretsmanager: Fake.GetTargetDomain is not implemented")
+	}
+	return f.GetTargetDomainFunc(tdID)
+}
+
+// GetTargetDomainAccount calls GetTargetDomainAccountFunc.
+func (f *Fake) GetTargetDomainAccount(tdID string, accountID string) (*ScannedAccount, error) {
+	if f.GetTargetDomainAccountFunc == nil {
+		var r0 *ScannedAccount
+		return r0, errors.New("secretsmanager: Fake.GetTargetDomainAccount is not implemented")
+	}
+	return f.GetTargetDomainAccountFunc(tdID, accountID)
+}
+
+// GetTargetDomainAccounts calls GetTargetDomainAccountsFunc.
+func (f *Fake) GetTargetDomainAccounts(tdID string, opts ...filters.Option) (*response.ResultSet[ScannedAccount], error) {
+	if f.GetTargetDomainAccountsFunc == nil {
+		var r0 *response.ResultSet[ScannedAccount]
+		return r0, errors.New("secretsmanager: Fake.GetTargetDomainAccounts is not implemented")
+	}
+	return f.GetTargetDomainAccountsFunc(tdID, opts...)
+}
+
+// GetTargetDomains calls GetTargetDomainsFunc.
+func (f *Fake) GetTargetDomains(opts ...filters.Option) (*response.ResultSet[TargetDomain], error) {
+	if f.GetTargetDomainsFunc == nil {
+		var r0 *response.ResultSet[TargetDomain]
+		return r0, errors.New("secretsmanager: Fake.GetTargetDomains is not implemented")
+	}
+	return f.GetTargetDomainsFunc(opts...)
+}
+
+// RefreshTargetDomain calls RefreshTargetDomainFunc.
+func (f *Fake) RefreshTargetDomain(tdID string) error {
+	if f.RefreshTargetDomainFunc == nil {
+		return errors.New("secretsmanager: Fake.RefreshTargetDomain is not implemented")
+	}
+	return f.RefreshTargetDomainFunc(tdID)
+}
+
+// ResolveTargetDomains calls ResolveTargetDomainsFunc.
+func (f *Fake) ResolveTargetDomains(tdNames []string) (TargetDomainsResolveResponse, error) {
+	if f.ResolveTargetDomainsFunc == nil {
+		var r0 TargetDomainsResolveResponse
+		return r0, errors.New("secretsmanager: Fake.ResolveTargetDomains is not implemented")
+	}
+	return f.ResolveTargetDomainsFunc(tdNames)
+}
+
+// RotateManagedAccountPassword calls RotateManagedAccountPasswordFunc.
+func (f *Fake) RotateManagedAccountPassword(tdID string, maID string) error {
+	if f.RotateManagedAccountPasswordFunc == nil {
+		return errors.New("secretsmanager: Fake.RotateManagedAccountPassword is not implemented")
+	}
+	return f.RotateManagedAccountPasswordFunc(tdID, maID)
+}
+
+// RotatePassword calls RotatePasswordFunc.
+func (f *Fake) RotatePassword(hostID string, account string) error {
+	if f.RotatePasswordFunc == nil {
+		return errors.New("secretsmanager: Fake.RotatePassword is not implemented")
+	}
+	return f.RotatePasswordFunc(hostID, account)
+}
+
+// SearchManagedAccounts calls SearchManagedAccountsFunc.
+func (f *Fake) SearchManagedAccounts(tdID string, search ManagedAccountsSearch, opts ...filters.Option) (*response.ResultSet[ManagedAccount], error) {
+	if f.SearchManagedAccountsFunc == nil {
+		var r0 *response.ResultSet[ManagedAccount]
+		return r0, errors.New("secretsmanager: Fake.SearchManagedAccounts is not implemented")
+	}
+	return f.SearchManagedAccountsFunc(tdID, search, opts...)
+}
+
+// SearchTargetDomain calls SearchTargetDomainFunc.
+func (f *Fake) SearchTargetDomain(search TargetDomainsSearch, opts ...filters.Option) (*response.ResultSet[TargetDomain], error) {
+	if f.SearchTargetDomainFunc == nil {
+		var r0 *response.ResultSet[TargetDomain]
+		return r0, errors.New("secretsmanager: Fake.SearchTargetDomain is not implemented")
+	}
+	return f.SearchTargetDomainFunc(search, opts...)
+}
+
+// SearchTargetDomainAccounts calls SearchTargetDomainAccountsFunc.
+func (f *Fake) SearchTargetDomainAccounts(tdID string, search ScannedAccountsSearch, opts ...filters.Option) (*response.ResultSet[ScannedAccount], error) {
+	if f.SearchTargetDomainAccountsFunc == nil {
+		var r0 *response.ResultSet[ScannedAccount]
+		return r0, errors.New("secretsmanager: Fake.SearchTargetDomainAccounts is not implemented")
+	}
+	return f.SearchTargetDomainAccountsFunc(tdID, search, opts...)
+}
+
+// SetManagedAccountPassword calls SetManagedAccountPasswordFunc.
+func (f *Fake) SetManagedAccountPassword(tdID ManagedAccountPasswordSet, maID ManagedAccountPasswordSet, password ManagedAccountPasswordSet) error {
+	if f.SetManagedAccountPasswordFunc == nil {
+		return errors.New("secretsmanager: Fake.SetManagedAccountPassword is not implemented")
+	}
+	return f.SetManagedAccountPasswordFunc(tdID, maID, password)
+}
+
+// Status calls StatusFunc.
+func (f *Fake) Status() (*response.ServiceStatus, error) {
+	if f.StatusFunc == nil {
+		var r0 *response.ServiceStatus
+		return r0, errors.New("secretsmanager: Fake.Status is not implemented")
+	}
+	return f.StatusFunc()
+}
+
+// UpdatePasswordPolicy calls UpdatePasswordPolicyFunc.
+func (f *Fake) UpdatePasswordPolicy(policyID string, policy *PasswordPolicy) error {
+	if f.UpdatePasswordPolicyFunc == nil {
+		return errors.New("secretsmanager: Fake.UpdatePasswordPolicy is not implemented")
+	}
+	return f.UpdatePasswordPolicyFunc(policyID, policy)
+}
+
+// UpdateScriptTemplate calls UpdateScriptTemplateFunc.
+func (f *Fake) UpdateScriptTemplate(templateID string, template *ScriptTemplate) error {
+	if f.UpdateScriptTemplateFunc == nil {
+		return errors.New("secretsmanager: Fake.UpdateScriptTemplate is not implemented")
+	}
+	return f.UpdateScriptTemplateFunc(templateID, template)
+}
+
+// UpdateTargetDomain calls UpdateTargetDomainFunc.
+func (f *Fake) UpdateTargetDomain(tdID string, td *TargetDomain) error {
+	if f.UpdateTargetDomainFunc == nil {
+		return errors.New("secretsmanager: Fake.UpdateTargetDomain is not implemented")
+	}
+	return f.UpdateTargetDomainFunc(tdID, td)
+}
+
+// UpdateTargetDomainAccount calls UpdateTargetDomainAccountFunc.
+func (f *Fake) UpdateTargetDomainAccount(tdID string, accountID string, change ScannedAccountChangeSet) error {
+	if f.UpdateTargetDomainAccountFunc == nil {
+		return errors.New("secretsmanager: Fake.UpdateTargetDomainAccount is not implemented")
+	}
+	return f.UpdateTargetDomainAccountFunc(tdID, accountID, change)
+}
+
+// UpdateTargetManagedAccount calls UpdateTargetManagedAccountFunc.
+func (f *Fake) UpdateTargetManagedAccount(tdID string, maID string, account *ManagedAccount) error {
+	if f.UpdateTargetManagedAccountFunc == nil {
+		return errors.New("secretsmanager: Fake.UpdateTargetManagedAccount is not implemented")
+	}
+	return f.UpdateTargetManagedAccountFunc(tdID, maID, account)
+}
@@ -6,6 +6,8 @@
 
 package settings
 
+//go:generate go run github.com/SSHcom/privx-sdk-go/v2/internal/mockgen
+
 import (
 	"encoding/json"
 	"net/url"
@@ -64,8 +66,8 @@ func (c *Settings) GetScopeSettings(scope string, opts ...filters.Option) (*json
 	settings := &json.RawMessage{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 	_, err := c.api.
 		URL("/settings/api/v1/settings/%s", scope).
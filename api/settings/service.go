@@ -0,0 +1,120 @@
+// Code generated by mockgen from Settings method set; DO NOT EDIT.
+
+package settings
+
+import (
+	"errors"
+
+	"encoding/json"
+	"github.com/SSHcom/privx-sdk-go/v2/api/filters"
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
+)
+
+// Service is the interface of Settings client, it allows substitution of
+// the client in tests, see Fake.
+type Service interface {
+	// GetScopeSchema get schema for the scope.
+	GetScopeSchema(scope string) (*json.RawMessage, error)
+	// GetScopeSettings get settings for the scope
+	GetScopeSettings(scope string, opts ...filters.Option) (*json.RawMessage, error)
+	// GetSectionSchema get schema for the section
+	GetSectionSchema(scope string, section string) (*json.RawMessage, error)
+	// GetSectionSettings get settings for the section.
+	GetSectionSettings(scope string, section string) (*json.RawMessage, error)
+	// Status get settings microservice status.
+	Status() (*response.ServiceStatus, error)
+	// UpdateScopeSettings update settings for a scope.
+	UpdateScopeSettings(settings map[string]interface{}, scope string) error
+	// UpdateSectionSettings update settings for a scope and section combination.
+	UpdateSectionSettings(scope string, section string, settings map[string]interface{}) error
+	// VerifyRestartRequired verify if restart is required for given settings scope.
+	VerifyRestartRequired(scope string, settings map[string]interface{}) (*map[string]interface{}, error)
+}
+
+var (
+	_ Service = (*Settings)(nil)
+	_ Service = (*Fake)(nil)
+)
+
+// Fake is a fake of Settings client. Methods delegate to the corresponding
+// function fields, undefined functions return an error.
+type Fake struct {
+	GetScopeSchemaFunc        func(string) (*json.RawMessage, error)
+	GetScopeSettingsFunc      func(string, ...filters.Option) (*json.RawMessage, error)
+	GetSectionSchemaFunc      func(string, string) (*json.RawMessage, error)
+	GetSectionSettingsFunc    func(string, string) (*json.RawMessage, error)
+	StatusFunc                func() (*response.ServiceStatus, error)
+	UpdateScopeSettingsFunc   func(map[string]interface{}, string) error
+	UpdateSectionSettingsFunc func(string, string, map[string]interface{}) error
+	VerifyRestartRequiredFunc func(string, map[string]interface{}) (*map[string]interface{}, error)
+}
+
+// GetScopeSchema calls GetScopeSchemaFunc.
+func (f *Fake) GetScopeSchema(scope string) (*json.RawMessage, error) {
+	if f.GetScopeSchemaFunc == nil {
+		var r0 *json.RawMessage
+		return r0, errors.New("settings: Fake.GetScopeSchema is not implemented")
+	}
+	return f.GetScopeSchemaFunc(scope)
+}
+
+// GetScopeSettings calls GetScopeSettingsFunc.
+func (f *Fake) GetScopeSettings(scope string, opts ...filters.Option) (*json.RawMessage, error) {
+	if f.GetScopeSettingsFunc == nil {
+		var r0 *json.RawMessage
+		return r0, errors.New("settings: Fake.GetScopeSettings is not implemented")
+	}
+	return f.GetScopeSettingsFunc(scope, opts...)
+}
+
+// GetSectionSchema calls GetSectionSchemaFunc.
+func (f *Fake) GetSectionSchema(scope string, section string) (*json.RawMessage, error) {
+	if f.GetSectionSchemaFunc == nil {
+		var r0 *json.RawMessage
+		return r0, errors.New("settings: Fake.GetSectionSchema is not implemented")
+	}
+	return f.GetSectionSchemaFunc(scope, section)
+}
+
+// GetSectionSettings calls GetSectionSettingsFunc.
+func (f *Fake) GetSectionSettings(scope string, section string) (*json.RawMessage, error) {
+	if f.GetSectionSettingsFunc == nil {
+		var r0 *json.RawMessage
+		return r0, errors.New("settings: Fake.GetSectionSettings is not implemented")
+	}
+	return f.GetSectionSettingsFunc(scope, section)
+}
+
+// Status calls StatusFunc.
+func (f *Fake) Status() (*response.ServiceStatus, error) {
+	if f.StatusFunc == nil {
+		var r0 *response.ServiceStatus
+		return r0, errors.New("settings: Fake.Status is not implemented")
+	}
+	return f.StatusFunc()
+}
+
+// UpdateScopeSettings calls UpdateScopeSettingsFunc.
+func (f *Fake) UpdateScopeSettings(settings map[string]interface{}, scope string) error {
+	if f.UpdateScopeSettingsFunc == nil {
+		return errors.New("settings: Fake.UpdateScopeSettings is not implemented")
+	}
+	return f.UpdateScopeSettingsFunc(settings, scope)
+}
+
+// UpdateSectionSettings calls UpdateSectionSettingsFunc.
+func (f *Fake) UpdateSectionSettings(scope string, section string, settings map[string]interface{}) error {
+	if f.UpdateSectionSettingsFunc == nil {
+		return errors.New("settings: Fake.UpdateSectionSettings is not implemented")
+	}
+	return f.UpdateSectionSettingsFunc(scope, section, settings)
+}
+
+// VerifyRestartRequired calls VerifyRestartRequiredFunc.
+func (f *Fake) VerifyRestartRequired(scope string, settings map[string]interface{}) (*map[string]interface{}, error) {
+	if f.VerifyRestartRequiredFunc == nil {
+		var r0 *map[string]interface{}
+		return r0, errors.New("settings: Fake.VerifyRestartRequired is not implemented")
+	}
+	return f.VerifyRestartRequiredFunc(scope, settings)
+}
@@ -6,6 +6,8 @@
 
 package trailindex
 
+//go:generate go run github.com/SSHcom/privx-sdk-go/v2/internal/mockgen
+
 import (
 	"net/url"
 
@@ -75,8 +77,8 @@ func (c *TrailIndex) SearchIndexes(search *TranscriptSearch, opts ...filters.Opt
 	indexes := &response.ResultSet[TrailIndexResponse]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -6,6 +6,8 @@
 
 package trailindex
 
+import "github.com/SSHcom/privx-sdk-go/v2/api/response"
+
 // TrailIndexResponse trail index response definition.
 type TrailIndexResponse struct {
 	ConnectionType string          `json:"connection_type"`
@@ -34,14 +36,14 @@ type TrailIndexExtra struct {
 
 // TranscriptSearch transcript search request object definition.
 type TranscriptSearch struct {
-	ConnID    string `json:"connection_id"`
-	ChanID    string `json:"channel_id"`
-	Protocol  string `json:"protocol"`
-	Keywords  string `json:"keywords"`
-	StartTime string `json:"start_time"`
-	EndTime   string `json:"end_time"`
-	StartPos  int    `json:"start_position"`
-	EndPos    int    `json:"end_position"`
+	ConnID    string             `json:"connection_id"`
+	ChanID    string             `json:"channel_id"`
+	Protocol  string             `json:"protocol"`
+	Keywords  string             `json:"keywords"`
+	StartTime response.Timestamp `json:"start_time"`
+	EndTime   response.Timestamp `json:"end_time"`
+	StartPos  int                `json:"start_position"`
+	EndPos    int                `json:"end_position"`
 }
 
 // ConnectionTranscriptStatus connection transcript status definition.
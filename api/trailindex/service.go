@@ -0,0 +1,85 @@
+// Code generated by mockgen from TrailIndex method set; DO NOT EDIT.
+
+package trailindex
+
+import (
+	"errors"
+
+	"github.com/SSHcom/privx-sdk-go/v2/api/filters"
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
+)
+
+// Service is the interface of TrailIndex client, it allows substitution of
+// the client in tests, see Fake.
+type Service interface {
+	// GetIndexingStatus get indexing status.
+	GetIndexingStatus(connectionID string) (ConnectionTranscriptStatus, error)
+	// GetIndexingStatuses gets the statuses of the specified connections.
+	GetIndexingStatuses(connectionIDs []string) ([]ConnectionTranscriptStatus, error)
+	// SearchIndexes search for the content based on the search parameters defined.
+	SearchIndexes(search *TranscriptSearch, opts ...filters.Option) (*response.ResultSet[TrailIndexResponse], error)
+	// StartIndexing starts indexing of the specified connections.
+	StartIndexing(connectionIDs []string) ([]ConnectionTranscriptStatus, error)
+	// Status get trail index microservice status.
+	Status() (*response.ServiceStatus, error)
+}
+
+var (
+	_ Service = (*TrailIndex)(nil)
+	_ Service = (*Fake)(nil)
+)
+
+// Fake is a fake of TrailIndex client. Methods delegate to the corresponding
+// function fields, undefined functions return an error.
+type Fake struct {
+	GetIndexingStatusFunc   func(string) (ConnectionTranscriptStatus, error)
+	GetIndexingStatusesFunc func([]string) ([]ConnectionTranscriptStatus, error)
+	SearchIndexesFunc       func(*TranscriptSearch, ...filters.Option) (*response.ResultSet[TrailIndexResponse], error)
+	StartIndexingFunc       func([]string) ([]ConnectionTranscriptStatus, error)
+	StatusFunc              func() (*response.ServiceStatus, error)
+}
+
+// GetIndexingStatus calls GetIndexingStatusFunc.
+func (f *Fake) GetIndexingStatus(connectionID string) (ConnectionTranscriptStatus, error) {
+	if f.GetIndexingStatusFunc == nil {
+		var r0 ConnectionTranscriptStatus
+		return r0, errors.New("trailindex: Fake.GetIndexingStatus is not implemented")
+	}
+	return f.GetIndexingStatusFunc(connectionID)
+}
+
+// GetIndexingStatuses calls GetIndexingStatusesFunc.
+func (f *Fake) GetIndexingStatuses(connectionIDs []string) ([]ConnectionTranscriptStatus, error) {
+	if f.GetIndexingStatusesFunc == nil {
+		var r0 []ConnectionTranscriptStatus
+		return r0, errors.New("trailindex: Fake.GetIndexingStatuses is not implemented")
+	}
+	return f.GetIndexingStatusesFunc(connectionIDs)
+}
+
+// SearchIndexes calls SearchIndexesFunc.
+func (f *Fake) SearchIndexes(search *TranscriptSearch, opts ...filters.Option) (*response.ResultSet[TrailIndexResponse], error) {
+	if f.SearchIndexesFunc == nil {
+		var r0 *response.ResultSet[TrailIndexResponse]
+		return r0, errors.New("trailindex: Fake.SearchIndexes is not implemented")
+	}
+	return f.SearchIndexesFunc(search, opts...)
+}
+
+// StartIndexing calls StartIndexingFunc.
+func (f *Fake) StartIndexing(connectionIDs []string) ([]ConnectionTranscriptStatus, error) {
+	if f.StartIndexingFunc == nil {
+		var r0 []ConnectionTranscriptStatus
+		return r0, errors.New("trailindex: Fake.StartIndexing is not implemented")
+	}
+	return f.StartIndexingFunc(connectionIDs)
+}
+
+// Status calls StatusFunc.
+func (f *Fake) Status() (*response.ServiceStatus, error) {
+	if f.StatusFunc == nil {
+		var r0 *response.ServiceStatus
+		return r0, errors.New("trailindex: Fake.Status is not implemented")
+	}
+	return f.StatusFunc()
+}
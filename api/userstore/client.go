@@ -6,6 +6,8 @@
 
 package userstore
 
+//go:generate go run github.com/SSHcom/privx-sdk-go/v2/internal/mockgen
+
 import (
 	"net/url"
 
@@ -42,8 +44,8 @@ func (c *UserStore) GetAPIClients(opts ...filters.Option) (*response.ResultSet[A
 	clients := &response.ResultSet[APIClient]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -123,8 +125,8 @@ func (c *UserStore) GetUsers(opts ...filters.Option) (*response.ResultSet[LocalU
 	users := &response.ResultSet[LocalUser]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -189,8 +191,8 @@ func (c *UserStore) GetUserTags(opts ...filters.Option) (*response.ResultSet[str
 	tags := &response.ResultSet[string]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -6,7 +6,10 @@
 
 package userstore
 
-import "github.com/SSHcom/privx-sdk-go/v2/api/rolestore"
+import (
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
+	"github.com/SSHcom/privx-sdk-go/v2/api/rolestore"
+)
 
 // LocalUserParams local user query parameters definition.
 type LocalUserParams struct {
@@ -16,33 +19,33 @@ type LocalUserParams struct {
 
 // TrustedClient trusted client definition.
 type TrustedClient struct {
-	ID                            string   `json:"id"`
-	Type                          string   `json:"type"`
-	Secret                        string   `json:"secret"`
-	Name                          string   `json:"name"`
-	AccessGroupID                 string   `json:"access_group_id"`
-	Created                       string   `json:"created,omitempty"`
-	Updated                       string   `json:"updated,omitempty"`
-	UpdatedBy                     string   `json:"updated_by,omitempty"`
-	Author                        string   `json:"author,omitempty"`
-	Permissions                   []string `json:"permissions"`
-	Subnets                       []string `json:"subnets"`
-	Enabled                       bool     `json:"enabled"`
-	Registered                    bool     `json:"registered"`
-	AllowRepeatedRegistration     bool     `json:"allow_repeated_registration"`
-	ExtenderAddress               []string `json:"extender_address"`
-	OAuthClientID                 string   `json:"oauth_client_id,omitempty"`
-	OAuthClientSecret             string   `json:"oauth_client_secret,omitempty"`
-	GroupID                       string   `json:"group_id"`
-	WebProxyAddress               string   `json:"web_proxy_address,omitempty"`
-	WebProxyPort                  string   `json:"web_proxy_port,omitempty"`
-	WebProxyExtenderRoutePatterns []string `json:"web_proxy_extender_route_patterns,omitempty"`
-	Data                          string   `json:"data,omitempty"`
-	RoutingPrefix                 string   `json:"routing_prefix"`
-	ExtenderMode                  string   `json:"extender_mode"`
-	ExtenderSSHAddress            string   `json:"extender_ssh_address,omitempty"`
-	ExtenderSSHPort               int      `json:"extender_ssh_port,omitempty"`
-	ExtenderPublicKey             string   `json:"extender_public_key,omitempty"`
+	ID                            string             `json:"id"`
+	Type                          string             `json:"type"`
+	Secret                        string             `json:"secret"`
+	Name                          string             `json:"name"`
+	AccessGroupID                 string             `json:"access_group_id"`
+	Created                       response.Timestamp `json:"created,omitzero"`
+	Updated                       response.Timestamp `json:"updated,omitzero"`
+	UpdatedBy                     string             `json:"updated_by,omitempty"`
+	Author                        string             `json:"author,omitempty"`
+	Permissions                   []string           `json:"permissions"`
+	Subnets                       []string           `json:"subnets"`
+	Enabled                       bool               `json:"enabled"`
+	Registered                    bool               `json:"registered"`
+	AllowRepeatedRegistration     bool               `json:"allow_repeated_registration"`
+	ExtenderAddress               []string           `json:"extender_address"`
+	OAuthClientID                 string             `json:"oauth_client_id,omitempty"`
+	OAuthClientSecret             string             `json:"oauth_client_secret,omitempty"`
+	GroupID                       string             `json:"group_id"`
+	WebProxyAddress               string             `json:"web_proxy_address,omitempty"`
+	WebProxyPort                  string             `json:"web_proxy_port,omitempty"`
+	WebProxyExtenderRoutePatterns []string           `json:"web_proxy_extender_route_patterns,omitempty"`
+	Data                          string             `json:"data,omitempty"`
+	RoutingPrefix                 string             `json:"routing_prefix"`
+	ExtenderMode                  string             `json:"extender_mode"`
+	ExtenderSSHAddress            string             `json:"extender_ssh_address,omitempty"`
+	ExtenderSSHPort               int                `json:"extender_ssh_port,omitempty"`
+	ExtenderPublicKey             string             `json:"extender_public_key,omitempty"`
 }
 
 type ExtenderClient struct {
@@ -62,8 +65,8 @@ type APIClient struct {
 	ID                string                 `json:"id"`
 	Secret            string                 `json:"secret"`
 	Name              string                 `json:"name"`
-	Created           string                 `json:"created,omitempty"`
-	Updated           string                 `json:"updated,omitempty"`
+	Created           response.Timestamp     `json:"created,omitzero"`
+	Updated           response.Timestamp     `json:"updated,omitzero"`
 	UpdatedBy         string                 `json:"updated_by,omitempty"`
 	Author            string                 `json:"author,omitempty"`
 	Roles             []rolestore.RoleHandle `json:"roles"`
@@ -88,29 +91,29 @@ type APIClientSearch struct {
 
 // LocalUser local user definition.
 type LocalUser struct {
-	ID                     string            `json:"id,omitempty"`
-	Created                string            `json:"created,omitempty"`
-	Updated                string            `json:"updated,omitempty"`
-	UpdatedBy              string            `json:"updated_by,omitempty"`
-	Author                 string            `json:"author,omitempty"`
-	Comment                string            `json:"comment,omitempty"`
-	Tags                   []string          `json:"tags,omitempty"`
-	Principal              string            `json:"username,omitempty"`
-	WindowsAccount         string            `json:"windows_account,omitempty"`
-	UnixAccount            string            `json:"unix_account,omitempty"`
-	FullName               string            `json:"full_name,omitempty"`
-	DisplayName            string            `json:"display_name,omitempty"`
-	FirstName              string            `json:"first_name,omitempty"`
-	LastName               string            `json:"last_name,omitempty"`
-	JobTitle               string            `json:"job_title,omitempty"`
-	Company                string            `json:"company,omitempty"`
-	Department             string            `json:"department,omitempty"`
-	Email                  string            `json:"email,omitempty"`
-	Telephone              string            `json:"telephone,omitempty"`
-	Locale                 string            `json:"locale,omitempty"`
-	Password               LocalUserPassword `json:"password"`
-	PasswordChangeRequired bool              `json:"password_change_required"`
-	Attributes             []Attributes      `json:"attributes"`
+	ID                     string             `json:"id,omitempty"`
+	Created                response.Timestamp `json:"created,omitzero"`
+	Updated                response.Timestamp `json:"updated,omitzero"`
+	UpdatedBy              string             `json:"updated_by,omitempty"`
+	Author                 string             `json:"author,omitempty"`
+	Comment                string             `json:"comment,omitempty"`
+	Tags                   []string           `json:"tags,omitempty"`
+	Principal              string             `json:"username,omitempty"`
+	WindowsAccount         string             `json:"windows_account,omitempty"`
+	UnixAccount            string             `json:"unix_account,omitempty"`
+	FullName               string             `json:"full_name,omitempty"`
+	DisplayName            string             `json:"display_name,omitempty"`
+	FirstName              string             `json:"first_name,omitempty"`
+	LastName               string             `json:"last_name,omitempty"`
+	JobTitle               string             `json:"job_title,omitempty"`
+	Company                string             `json:"company,omitempty"`
+	Department             string             `json:"department,omitempty"`
+	Email                  string             `json:"email,omitempty"`
+	Telephone              string             `json:"telephone,omitempty"`
+	Locale                 string             `json:"locale,omitempty"`
+	Password               LocalUserPassword  `json:"password"`
+	PasswordChangeRequired bool               `json:"password_change_required"`
+	Attributes             []Attributes       `json:"attributes"`
 }
 
 // Attributes user attribute definition.
@@ -121,6 +124,6 @@ type Attributes struct {
 
 // LocalUserPassword local user password definition.
 type LocalUserPassword struct {
-	Password string `json:"password,omitempty"`
-	Created  string `json:"created,omitempty"`
+	Password string             `json:"password,omitempty"`
+	Created  response.Timestamp `json:"created,omitzero"`
 }
@@ -0,0 +1,258 @@
+// Code generated by mockgen from UserStore method set; DO NOT EDIT.
+
+package userstore
+
+import (
+	"errors"
+
+	"github.com/SSHcom/privx-sdk-go/v2/api/filters"
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
+)
+
+// Service is the interface of UserStore client, it allows substitution of
+// the client in tests, see Fake.
+type Service interface {
+	// CreateAPIClient create api client.
+	CreateAPIClient(client *APIClientCreate) (response.Identifier, error)
+	// CreateTrustedClient created trusted client.
+	CreateTrustedClient(client *TrustedClient) (response.Identifier, error)
+	// CreateUser create local user.
+	CreateUser(user *LocalUser) (response.Identifier, error)
+	// DeleteAPIClient delete api client.
+	DeleteAPIClient(clientID string) error
+	// DeleteTrustedClient delete trusted client.
+	DeleteTrustedClient(clientID string) error
+	// DeleteUser delete local user.
+	DeleteUser(userID string) error
+	// GetAPIClient get api client by id.
+	GetAPIClient(clientID string) (*APIClient, error)
+	// GetAPIClients get registered api clients.
+	GetAPIClients(opts ...filters.Option) (*response.ResultSet[APIClient], error)
+	// GetExtenderClients get extender clients.
+	GetExtenderClients() (*response.ResultSet[ExtenderClient], error)
+	// GetTrustedClient get trusted client by id.
+	GetTrustedClient(clientID string) (*TrustedClient, error)
+	// GetTrustedClients get trusted clients.
+	GetTrustedClients() (*response.ResultSet[TrustedClient], error)
+	// GetUser get local user by id.
+	GetUser(userID string) (*LocalUser, error)
+	// GetUserTags get local user tags.
+	GetUserTags(opts ...filters.Option) (*response.ResultSet[string], error)
+	// GetUsers get local users.
+	GetUsers(opts ...filters.Option) (*response.ResultSet[LocalUser], error)
+	// SearchAPIClients search api clients.
+	SearchAPIClients(search *APIClientSearch) (*response.ResultSet[APIClient], error)
+	// Status get local user store microservice status.
+	Status() (*response.ServiceStatus, error)
+	// UpdateAPIClient update api client.
+	UpdateAPIClient(clientID string, client *APIClient) error
+	// UpdateTrustedClient update trusted client.
+	UpdateTrustedClient(clientID string, client *TrustedClient) error
+	// UpdateUser update local user.
+	UpdateUser(userID string, user *LocalUser) error
+	// UpdateUserPassword update local user password.
+	UpdateUserPassword(userID string, password LocalUserPassword) error
+}
+
+var (
+	_ Service = (*UserStore)(nil)
+	_ Service = (*Fake)(nil)
+)
+
+// Fake is a fake of UserStore client. Methods delegate to the corresponding
+// function fields, undefined functions return an error.
+type Fake struct {
+	CreateAPIClientFunc     func(*APIClientCreate) (response.Identifier, error)
+	CreateTrustedClientFunc func(*TrustedClient) (response.Identifier, error)
+	CreateUserFunc          func(*LocalUser) (response.Identifier, error)
+	DeleteAPIClientFunc     func(string) error
+	DeleteTrustedClientFunc func(string) error
+	DeleteUserFunc          func(string) error
+	GetAPIClientFunc        func(string) (*APIClient, error)
+	GetAPIClientsFunc       func(...filters.Option) (*response.ResultSet[APIClient], error)
+	GetExtenderClientsFunc  func() (*response.ResultSet[ExtenderClient], error)
+	GetTrustedClientFunc    func(string) (*TrustedClient, error)
+	GetTrustedClientsFunc   func() (*response.ResultSet[TrustedClient], error)
+	GetUserFunc             func(string) (*LocalUser, error)
+	GetUserTagsFunc         func(...filters.Option) (*response.ResultSet[string], error)
+	GetUsersFunc            func(...filters.Option) (*response.ResultSet[LocalUser], error)
+	SearchAPIClientsFunc    func(*APIClientSearch) (*response.ResultSet[APIClient], error)
+	StatusFunc              func() (*response.ServiceStatus, error)
+	UpdateAPIClientFunc     func(string, *APIClient) error
+	UpdateTrustedClientFunc func(string, *TrustedClient) error
+	UpdateUserFunc          func(string, *LocalUser) error
+	UpdateUserPasswordFunc  func(string, LocalUserPassword) error
+}
+
+// CreateAPIClient calls CreateAPIClientFunc.
+func (f *Fake) CreateAPIClient(client *APIClientCreate) (response.Identifier, error) {
+	if f.CreateAPIClientFunc == nil {
+		var r0 response.Identifier
+		return r0, errors.New("userstore: Fake.CreateAPIClient is not implemented")
+	}
+	return f.CreateAPIClientFunc(client)
+}
+
+// CreateTrustedClient calls CreateTrustedClientFunc.
+func (f *Fake) CreateTrustedClient(client *TrustedClient) (response.Identifier, error) {
+	if f.CreateTrustedClientFunc == nil {
+		var r0 response.Identifier
+		return r0, errors.New("userstore: Fake.CreateTrustedClient is not implemented")
+	}
+	return f.CreateTrustedClientFunc(client)
+}
+
+// CreateUser calls CreateUserFunc.
+func (f *Fake) CreateUser(user *LocalUser) (response.Identifier, error) {
+	if f.CreateUserFunc == nil {
+		var r0 response.Identifier
+		return r0, errors.New("userstore: Fake.CreateUser is not implemented")
+	}
+	return f.CreateUserFunc(user)
+}
+
+// DeleteAPIClient calls DeleteAPIClientFunc.
+func (f *Fake) DeleteAPIClient(clientID string) error {
+	if f.DeleteAPIClientFunc == nil {
+		return errors.New("userstore: Fake.DeleteAPIClient is not implemented")
+	}
+	return f.DeleteAPIClientFunc(clientID)
+}
+
+// DeleteTrustedClient calls DeleteTrustedClientFunc.
+func (f *Fake) DeleteTrustedClient(clientID string) error {
+	if f.DeleteTrustedClientFunc == nil {
+		return errors.New("userstore: Fake.DeleteTrustedClient is not implemented")
+	}
+	return f.DeleteTrustedClientFunc(clientID)
+}
+
+// DeleteUser calls DeleteUserFunc.
+func (f *Fake) DeleteUser(userID string) error {
+	if f.DeleteUserFunc == nil {
+		return errors.New("userstore: Fake.DeleteUser is not implemented")
+	}
+	return f.DeleteUserFunc(userID)
+}
+
+// GetAPIClient calls GetAPIClientFunc.
+func (f *Fake) GetAPIClient(clientID string) (*APIClient, error) {
+	if f.GetAPIClientFunc == nil {
+		var r0 *APIClient
+		return r0, errors.New("userstore: Fake.GetAPIClient is not implemented")
+	}
+	return f.GetAPIClientFunc(clientID)
+}
+
+// GetAPIClients calls GetAPIClientsFunc.
+func (f *Fake) GetAPIClients(opts ...filters.Option) (*response.ResultSet[APIClient], error) {
+	if f.GetAPIClientsFunc == nil {
+		var r0 *response.ResultSet[APIClient]
+		return r0, errors.New("userstore: Fake.GetAPIClients is not implemented")
+	}
+	return f.GetAPIClientsFunc(opts...)
+}
+
+// GetExtenderClients calls GetExtenderClientsFunc.
+func (f *Fake) GetExtenderClients() (*response.ResultSet[ExtenderClient], error) {
+	if f.GetExtenderClientsFunc == nil {
+		var r0 *response.ResultSet[ExtenderClient]
+		return r0, errors.New("userstore: Fake.GetExtenderClients is not implemented")
+	}
+	return f.GetExtenderClientsFunc()
+}
+
+// GetTrustedClient calls GetTrustedClientFunc.
+func (f *Fake) GetTrustedClient(clientID string) (*TrustedClient, error) {
+	if f.GetTrustedClientFunc == nil {
+		var r0 *TrustedClient
+		return r0, errors.New("userstore: Fake.GetTrustedClient is not implemented")
+	}
+	return f.GetTrustedClientFunc(clientID)
+}
+
+// GetTrustedClients calls GetTrustedClientsFunc.
+func (f *Fake) GetTrustedClients() (*response.ResultSet[TrustedClient], error) {
+	if f.GetTrustedClientsFunc == nil {
+		var r0 *response.ResultSet[TrustedClient]
+		return r0, errors.New("userstore: Fake.GetTrustedClients is not implemented")
+	}
+	return f.GetTrustedClientsFunc()
+}
+
+// GetUser calls GetUserFunc.
+func (f *Fake) GetUser(userID string) (*LocalUser, error) {
+	if f.GetUserFunc == nil {
+		var r0 *LocalUser
+		return r0, errors.New("userstore: Fake.GetUser is not implemented")
+	}
+	return f.GetUserFunc(userID)
+}
+
+// GetUserTags calls GetUserTagsFunc.
+func (f *Fake) GetUserTags(opts ...filters.Option) (*response.ResultSet[string], error) {
+	if f.GetUserTagsFunc == nil {
+		var r0 *response.ResultSet[string]
+		return r0, errors.New("userstore: Fake.GetUserTags is not implemented")
+	}
+	return f.GetUserTagsFunc(opts...)
+}
+
+// GetUsers calls GetUsersFunc.
+func (f *Fake) GetUsers(opts ...filters.Option) (*response.ResultSet[LocalUser], error) {
+	if f.GetUsersFunc == nil {
+		var r0 *response.ResultSet[LocalUser]
+		return r0, errors.New("userstore: Fake.GetUsers is not implemented")
+	}
+	return f.GetUsersFunc(opts...)
+}
+
+// SearchAPIClients calls SearchAPIClientsFunc.
+func (f *Fake) SearchAPIClients(search *APIClientSearch) (*response.ResultSet[APIClient], error) {
+	if f.SearchAPIClientsFunc == nil {
+		var r0 *response.ResultSet[APIClient]
+		return r0, errors.New("userstore: Fake.SearchAPIClients is not implemented")
+	}
+	return f.SearchAPIClientsFunc(search)
+}
+
+// Status calls StatusFunc.
+func (f *Fake) Status() (*response.ServiceStatus, error) {
+	if f.StatusFunc == nil {
+		var r0 *response.ServiceStatus
+		return r0, errors.New("userstore: Fake.Status is not implemented")
+	}
+	return f.StatusFunc()
+}
+
+// UpdateAPIClient calls UpdateAPIClientFunc.
+func (f *Fake) UpdateAPIClient(clientID string, client *APIClient) error {
+	if f.UpdateAPIClientFunc == nil {
+		return errors.New("userstore: Fake.UpdateAPIClient is not implemented")
+	}
+	return f.UpdateAPIClientFunc(clientID, client)
+}
+
+// UpdateTrustedClient calls UpdateTrustedClientFunc.
+func (f *Fake) UpdateTrustedClient(clientID string, client *TrustedClient) error {
+	if f.UpdateTrustedClientFunc == nil {
+		return errors.New("userstore: Fake.UpdateTrustedClient is not implemented")
+	}
+	return f.UpdateTrustedClientFunc(clientID, client)
+}
+
+// UpdateUser calls UpdateUserFunc.
+func (f *Fake) UpdateUser(userID string, user *LocalUser) error {
+	if f.UpdateUserFunc == nil {
+		return errors.New("userstore: Fake.UpdateUser is not implemented")
+	}
+	return f.UpdateUserFunc(userID, user)
+}
+
+// UpdateUserPassword calls UpdateUserPasswordFunc.
+func (f *Fake) UpdateUserPassword(userID string, password LocalUserPassword) error {
+	if f.UpdateUserPasswordFunc == nil {
+		return errors.New("userstore: Fake.UpdateUserPassword is not implemented")
+	}
+	return f.UpdateUserPasswordFunc(userID, password)
+}
@@ -6,8 +6,9 @@
 
 package vault
 
+//go:generate go run github.com/SSHcom/privx-sdk-go/v2/internal/mockgen
+
 import (
-	"encoding/json"
 	"net/url"
 
 	"github.com/SSHcom/privx-sdk-go/v2/api/filters"
@@ -66,8 +67,8 @@ func (c *Vault) GetUserSecrets(userID string, opts ...filters.Option) (*response
 	secrets := &response.ResultSet[Secret]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -120,8 +121,8 @@ func (c *Vault) DeleteUserSecret(userID, secretName string) error {
 
 // MARK: Schemas
 // GetSchemas get the defined vault schemas.
-func (c *Vault) GetSchemas() (*json.RawMessage, error) {
-	schemas := &json.RawMessage{}
+func (c *Vault) GetSchemas() (Schemas, error) {
+	schemas := Schemas{}
 
 	_, err := c.api.
 		URL("/vault/api/v1/schemas").
@@ -136,8 +137,8 @@ func (c *Vault) GetSecrets(opts ...filters.Option) (*response.ResultSet[Secret],
 	secrets := &response.ResultSet[Secret]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -193,8 +194,8 @@ func (c *Vault) SearchSecrets(search SecretSearch, opts ...filters.Option) (*res
 	secrets := &response.ResultSet[Secret]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -9,6 +9,7 @@ package vault
 import (
 	"time"
 
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
 	"github.com/SSHcom/privx-sdk-go/v2/api/rolestore"
 )
 
@@ -46,3 +47,31 @@ type SecretSearch struct {
 	Limit    int      `json:"limit"`
 	Offset   int      `json:"offset"`
 }
+
+// Schema JSON schema of vault secret data.
+type Schema struct {
+	Title       string            `json:"title,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Type        string            `json:"type,omitempty"`
+	Format      string            `json:"format,omitempty"`
+	Required    []string          `json:"required,omitempty"`
+	Properties  map[string]Schema `json:"properties,omitempty"`
+	Items       *Schema           `json:"items,omitempty"`
+
+	// Extra holds schema keywords unknown to the model.
+	Extra response.Extra `json:"-"`
+}
+
+func (s *Schema) UnmarshalJSON(data []byte) (err error) {
+	type schema Schema
+	s.Extra, err = response.DecodeExtra(data, (*schema)(s))
+	return
+}
+
+func (s Schema) MarshalJSON() ([]byte, error) {
+	type schema Schema
+	return response.EncodeExtra(schema(s), s.Extra)
+}
+
+// Schemas vault schemas keyed by schema name.
+type Schemas map[string]Schema
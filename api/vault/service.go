@@ -0,0 +1,201 @@
+// Code generated by mockgen from Vault method set; DO NOT EDIT.
+
+package vault
+
+import (
+	"errors"
+
+	"github.com/SSHcom/privx-sdk-go/v2/api/filters"
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
+)
+
+// Service is the interface of Vault client, it allows substitution of
+// the client in tests, see Fake.
+type Service interface {
+	// CreateSecret create secret.
+	CreateSecret(secret *SecretRequest) (SecretCreate, error)
+	// CreateUserSecret create user secret.
+	CreateUserSecret(userID string, secret *SecretRequest) (SecretCreate, error)
+	// DeleteSecret delete secret.
+	DeleteSecret(secretName string) error
+	// DeleteUserSecret delete user secret.
+	DeleteUserSecret(userID string, secretName string) error
+	// GetSchemas get the defined vault schemas.
+	GetSchemas() (Schemas, error)
+	// GetSecret get secret by secret name.
+	GetSecret(secretName string) (*Secret, error)
+	// GetSecrets get secrets.
+	GetSecrets(opts ...filters.Option) (*response.ResultSet[Secret], error)
+	// GetSecretsMetadata get secrets metadata.
+	GetSecretsMetadata(name string) (*Secret, error)
+	// UserSecret get user secret by secret name.
+	GetUserSecret(userID string, secretName string) (*Secret, error)
+	// GetUserSecrets get user secrets.
+	GetUserSecrets(userID string, opts ...filters.Option) (*response.ResultSet[Secret], error)
+	// GetUsersSecretsMetadata get users secrets metadata.
+	GetUsersSecretsMetadata(userID string, name string) (*Secret, error)
+	// SearchSecrets search secrets.
+	SearchSecrets(search SecretSearch, opts ...filters.Option) (*response.ResultSet[Secret], error)
+	// Status get role store microservice status.
+	Status() (*response.ServiceStatus, error)
+	// UpdateSecret update secret.
+	UpdateSecret(secretName string, secret *SecretRequest) error
+	// UpdateUserSecret update user secret.
+	UpdateUserSecret(userID string, secretName string, secret *SecretRequest) error
+}
+
+var (
+	_ Service = (*Vault)(nil)
+	_ Service = (*Fake)(nil)
+)
+
+// Fake is a fake of Vault client. Methods delegate to the corresponding
+// function fields, undefined functions return an error.
+type Fake struct {
+	CreateSecretFunc            func(*SecretRequest) (SecretCreate, error)
+	CreateUserSecretFunc        func(string, *SecretRequest) (SecretCreate, error)
+	DeleteSecretFunc            func(string) error
+	DeleteUserSecretFunc        func(string, string) error
+	GetSchemasFunc              func() (Schemas, error)
+	GetSecretFunc               func(string) (*Secret, error)
+	GetSecretsFunc              func(...filters.Option) (*response.ResultSet[Secret], error)
+	GetSecretsMetadataFunc      func(string) (*Secret, error)
+	GetUserSecretFunc           func(string, string) (*Secret, error)
+	GetUserSecretsFunc          func(string, ...filters.Option) (*response.ResultSet[Secret], error)
+	GetUsersSecretsMetadataFunc func(string, string) (*Secret, error)
+	SearchSecretsFunc           func(SecretSearch, ...filters.Option) (*response.ResultSet[Secret], error)
+	StatusFunc                  func() (*response.ServiceStatus, error)
+	UpdateSecretFunc            func(string, *SecretRequest) error
+	UpdateUserSecretFunc        func(string, string, *SecretRequest) error
+}
+
+// CreateSecret calls CreateSecretFunc.
+func (f *Fake) CreateSecret(secret *SecretRequest) (SecretCreate, error) {
+	if f.CreateSecretFunc == nil {
+		var r0 SecretCreate
+		return r0, errors.New("vault: Fake.CreateSecret is not implemented")
+	}
+	return f.CreateSecretFunc(secret)
+}
+
+// CreateUserSecret calls CreateUserSecretFunc.
+func (f *Fake) CreateUserSecret(userID string, secret *SecretRequest) (SecretCreate, error) {
+	if f.CreateUserSecretFunc == nil {
+		var r0 SecretCreate
+		return r0, errors.New("vault: Fake.CreateUserSecret is not implemented")
+	}
+	return f.CreateUserSecretFunc(userID, secret)
+}
+
+// DeleteSecret calls DeleteSecretFunc.
+func (f *Fake) DeleteSecret(secretName string) error {
+	if f.DeleteSecretFunc == nil {
+		return errors.New("vault: Fake.DeleteSecret is not implemented")
+	}
+	return f.DeleteSecretFunc(secretName)
+}
+
+// DeleteUserSecret calls DeleteUserSecretFunc.
+func (f *Fake) DeleteUserSecret(userID string, secretName string) error {
+	if f.DeleteUserSecretFunc == nil {
+		return errors.New("vault: Fake.DeleteUserSecret is not implemented")
+	}
+	return f.DeleteUserSecretFunc(userID, secretName)
+}
+
+// GetSchemas calls GetSchemasFunc.
+func (f *Fake) GetSchemas() (Schemas, error) {
+	if f.GetSchemasFunc == nil {
+		var r0 Schemas
+		return r0, errors.New("vault: Fake.GetSchemas is not implemented")
+	}
+	return f.GetSchemasFunc()
+}
+
+// GetSecret calls GetSecretFunc.
+func (f *Fake) GetSecret(secretName string) (*Secret, error) {
+	if f.GetSecretFunc == nil {
+		var r0 *Secret
+		return r0, errors.New("vault: Fake.GetSecret is not implemented")
+	}
+	return f.GetSecretFunc(secretName)
+}
+
+// GetSecrets calls GetSecretsFunc.
+func (f *Fake) GetSecrets(opts ...filters.Option) (*response.ResultSet[Secret], error) {
+	if f.GetSecretsFunc == nil {
+		var r0 *response.ResultSet[Secret]
+		return r0, errors.New("vault: Fake.GetSecrets is not implemented")
+	}
+	return f.GetSecretsFunc(opts...)
+}
+
+// GetSecretsMetadata calls GetSecretsMetadataFunc.
+func (f *Fake) GetSecretsMetadata(name string) (*Secret, error) {
+	if f.GetSecretsMetadataFunc == nil {
+		var r0 *Secret
+		return r0, errors.New("vault: Fake.GetSecretsMetadata is not implemented")
+	}
+	return f.GetSecretsMetadataFunc(name)
+}
+
+// GetUserSecret calls GetUserSecretFunc.
+func (f *Fake) GetUserSecret(userID string, secretName string) (*Secret, error) {
+	if f.GetUserSecretFunc == nil {
+		var r0 *Secret
+		return r0, errors.New("vault: Fake.GetUserSecret is not implemented")
+	}
+	return f.GetUserSecretFunc(userID, secretName)
+}
+
+// GetUserSecrets calls GetUserSecretsFunc.
+func (f *Fake) GetUserSecrets(userID string, opts ...filters.Option) (*response.ResultSet[Secret], error) {
+	if f.GetUserSecretsFunc == nil {
+		var r0 *response.ResultSet[Secret]
+		return r0, errors.New("vault: Fake.GetUserSecrets is not implemented")
+	}
+	return f.GetUserSecretsFunc(userID, opts...)
+}
+
+// GetUsersSecretsMetadata calls GetUsersSecretsMetadataFunc.
+func (f *Fake) GetUsersSecretsMetadata(userID string, name string) (*Secret, error) {
+	if f.GetUsersSecretsMetadataFunc == nil {
+		var r0 *Secret
+		return r0, errors.New("vault: Fake.GetUsersSecretsMetadata is not implemented")
+	}
+	return f.GetUsersSecretsMetadataFunc(userID, name)
+}
+
+// SearchSecrets calls SearchSecretsFunc.
+func (f *Fake) SearchSecrets(search SecretSearch, opts ...filters.Option) (*response.ResultSet[Secret], error) {
+	if f.SearchSecretsFunc == nil {
+		var r0 *response.ResultSet[Secret]
+		return r0, errors.New("vault: Fake.SearchSecrets is not implemented")
+	}
+	return f.SearchSecretsFunc(search, opts...)
+}
+
+// Status calls StatusFunc.
+func (f *Fake) Status() (*response.ServiceStatus, error) {
+	if f.StatusFunc == nil {
+		var r0 *response.ServiceStatus
+		return r0, errors.New("vault: Fake.Status is not implemented")
+	}
+	return f.StatusFunc()
+}
+
+// UpdateSecret calls UpdateSecretFunc.
+func (f *Fake) UpdateSecret(secretName string, secret *SecretRequest) error {
+	if f.UpdateSecretFunc == nil {
+		return errors.New("vault: Fake.UpdateSecret is not implemented")
+	}
+	return f.UpdateSecretFunc(secretName, secret)
+}
+
+// UpdateUserSecret calls UpdateUserSecretFunc.
+func (f *Fake) UpdateUserSecret(userID string, secretName string, secret *SecretRequest) error {
+	if f.UpdateUserSecretFunc == nil {
+		return errors.New("vault: Fake.UpdateUserSecret is not implemented")
+	}
+	return f.UpdateUserSecretFunc(userID, secretName, secret)
+}
@@ -6,6 +6,8 @@
 
 package workflow
 
+//go:generate go run github.com/SSHcom/privx-sdk-go/v2/internal/mockgen
+
 import (
 	"net/url"
 
@@ -42,8 +44,8 @@ func (c *WorkflowEngine) GetWorkflows(opts ...filters.Option) (*response.ResultS
 	workflows := &response.ResultSet[Workflow]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -100,8 +102,8 @@ func (c *WorkflowEngine) GetRequests(opts ...filters.Option) (*response.ResultSe
 	requests := &response.ResultSet[AccessRequest]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -145,6 +147,10 @@ func (c *WorkflowEngine) DeleteRequest(requestID string) error {
 
 // UpdateDecisionOnRequest update a request decision in queue.
 func (c *WorkflowEngine) UpdateDecisionOnRequest(requestID string, request Decision) error {
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
 	_, err := c.api.
 		URL("/workflow-engine/api/v1/requests/%s/decision", requestID).
 		Post(&request)
@@ -166,8 +172,8 @@ func (c *WorkflowEngine) SearchRequests(search *AccessRequestSearch, opts ...fil
 	requests := &response.ResultSet[AccessRequest]{}
 	params := url.Values{}
 
-	for _, opt := range opts {
-		opt(&params)
+	if err := filters.Apply(&params, opts...); err != nil {
+		return nil, err
 	}
 
 	_, err := c.api.
@@ -6,6 +6,18 @@
 
 package workflow
 
+import "github.com/SSHcom/privx-sdk-go/v2/api/response"
+
+const (
+	// Enumerated values for request decisions.
+	DecisionPending  DecisionStatus = "pending"
+	DecisionApproved DecisionStatus = "approved"
+	DecisionDenied   DecisionStatus = "denied"
+)
+
+// DecisionStatus definition for decisions of request step approvers.
+type DecisionStatus string
+
 // SMTPResponse smtp server test response definition.
 type SMTPResponse struct {
 	Status  string      `json:"status"`
@@ -61,40 +73,40 @@ type WorkflowUser struct {
 
 // Workflow workflow definition
 type Workflow struct {
-	ID                        string         `json:"id"`
-	Author                    string         `json:"author"`
-	Created                   string         `json:"created"`
-	Updated                   string         `json:"updated"`
-	UpdatedBy                 string         `json:"updated_by"`
-	Name                      string         `json:"name"`
-	GrantTypes                []string       `json:"grant_types,omitempty"`
-	MaxTimeRestrictedDuration int64          `json:"max_time_restricted_duration,omitempty"`
-	MaxFloatingDuration       int64          `json:"max_floating_duration,omitempty"`
-	MaxActiveRequests         int64          `json:"max_active_requests"`
-	TargetRoles               []WorkflowRole `json:"target_roles,omitempty"`
-	RequestorRoles            []WorkflowRole `json:"requester_roles,omitempty"`
-	Action                    string         `json:"action,omitempty"`
-	CanBypassRevokeWF         bool           `json:"can_bypass_revoke_workflow"`
-	Comment                   string         `json:"comment,omitempty"`
-	Steps                     []WorkflowStep `json:"steps,omitempty"`
-	RequiresJustification     bool           `json:"requires_justification"`
+	ID                        string             `json:"id"`
+	Author                    string             `json:"author"`
+	Created                   response.Timestamp `json:"created"`
+	Updated                   response.Timestamp `json:"updated"`
+	UpdatedBy                 string             `json:"updated_by"`
+	Name                      string             `json:"name"`
+	GrantTypes                []string           `json:"grant_types,omitempty"`
+	MaxTimeRestrictedDuration int64              `json:"max_time_restricted_duration,omitempty"`
+	MaxFloatingDuration       int64              `json:"max_floating_duration,omitempty"`
+	MaxActiveRequests         int64              `json:"max_active_requests"`
+	TargetRoles               []WorkflowRole     `json:"target_roles,omitempty"`
+	RequestorRoles            []WorkflowRole     `json:"requester_roles,omitempty"`
+	Action                    string             `json:"action,omitempty"`
+	CanBypassRevokeWF         bool               `json:"can_bypass_revoke_workflow"`
+	Comment                   string             `json:"comment,omitempty"`
+	Steps                     []WorkflowStep     `json:"steps,omitempty"`
+	RequiresJustification     bool               `json:"requires_justification"`
 }
 
 // Decision request decision definition.
 type Decision struct {
-	Step     int    `json:"step"`
-	Decision string `json:"decision"`
-	Comment  string `json:"comment,omitempty"`
+	Step     int            `json:"step"`
+	Decision DecisionStatus `json:"decision"`
+	Comment  string         `json:"comment,omitempty"`
 }
 
 // RequestStepApprover request step approver definition.
 type RequestStepApprover struct {
-	ID           string        `json:"id"`
-	Role         WorkflowRole  `json:"role"`
-	Decision     string        `json:"decision"`
-	User         *WorkflowUser `json:"user,omitempty"`
-	DecisionTime *string       `json:"decision_time,omitempty"`
-	Comment      string        `json:"comment"`
+	ID           string             `json:"id"`
+	Role         WorkflowRole       `json:"role"`
+	Decision     DecisionStatus     `json:"decision"`
+	User         *WorkflowUser      `json:"user,omitempty"`
+	DecisionTime response.Timestamp `json:"decision_time,omitzero"`
+	Comment      string             `json:"comment"`
 }
 
 // RequestStep request step definition.
@@ -107,34 +119,34 @@ type RequestStep struct {
 
 // AccessRequest access request definition.
 type AccessRequest struct {
-	ID                   string        `json:"id"`
-	Author               string        `json:"author"`
-	Created              string        `json:"created"`
-	Updated              string        `json:"updated"`
-	UpdatedBy            string        `json:"updated_by"`
-	Name                 string        `json:"name"`
-	Requester            *WorkflowUser `json:"requester,omitempty"`
-	RequestedRole        *WorkflowRole `json:"requested_role,omitempty"`
-	RequestJustification string        `json:"request_justification"`
-	GrantType            string        `json:"grant_type,omitempty"`
-	GrantStart           string        `json:"grant_start,omitempty"`
-	GrantEnd             string        `json:"grant_end,omitempty"`
-	FloatingLength       int64         `json:"floating_length,omitempty"`
-	TargetUser           *WorkflowUser `json:"target_user,omitempty"`
-	Action               string        `json:"action,omitempty"`
-	Status               string        `json:"status,omitempty"`
-	Comment              string        `json:"comment,omitempty"`
-	Steps                []RequestStep `json:"steps,omitempty"`
-	ApproverCanRevoke    bool          `json:"approver_can_revoke"`
-	TargetRoleRevoked    bool          `json:"target_role_revoked"`
-	TargetRoleRevokeTime *string       `json:"target_role_revocation_time,omitempty"`
-	TargetRoleRevokedBy  *WorkflowUser `json:"target_role_revoked_by,omitempty"`
+	ID                   string             `json:"id"`
+	Author               string             `json:"author"`
+	Created              response.Timestamp `json:"created"`
+	Updated              response.Timestamp `json:"updated"`
+	UpdatedBy            string             `json:"updated_by"`
+	Name                 string             `json:"name"`
+	Requester            *WorkflowUser      `json:"requester,omitempty"`
+	RequestedRole        *WorkflowRole      `json:"requested_role,omitempty"`
+	RequestJustification string             `json:"request_justification"`
+	GrantType            string             `json:"grant_type,omitempty"`
+	GrantStart           response.Timestamp `json:"grant_start,omitzero"`
+	GrantEnd             response.Timestamp `json:"grant_end,omitzero"`
+	FloatingLength       int64              `json:"floating_length,omitempty"`
+	TargetUser           *WorkflowUser      `json:"target_user,omitempty"`
+	Action               string             `json:"action,omitempty"`
+	Status               string             `json:"status,omitempty"`
+	Comment              string             `json:"comment,omitempty"`
+	Steps                []RequestStep      `json:"steps,omitempty"`
+	ApproverCanRevoke    bool               `json:"approver_can_revoke"`
+	TargetRoleRevoked    bool               `json:"target_role_revoked"`
+	TargetRoleRevokeTime response.Timestamp `json:"target_role_revocation_time,omitzero"`
+	TargetRoleRevokedBy  *WorkflowUser      `json:"target_role_revoked_by,omitempty"`
 }
 
 // AccessRequestSearch access request search definition.
 type AccessRequestSearch struct {
-	Keywords  string `json:"keywords,omitempty"`
-	StartTime string `json:"start_time,omitempty"`
-	EndTime   string `json:"end_time,omitempty"`
-	Filter    string `json:"filter,omitempty"`
+	Keywords  string             `json:"keywords,omitempty"`
+	StartTime response.Timestamp `json:"start_time,omitzero"`
+	EndTime   response.Timestamp `json:"end_time,omitzero"`
+	Filter    string             `json:"filter,omitempty"`
 }
@@ -0,0 +1,211 @@
+// Code generated by mockgen from WorkflowEngine method set; DO NOT EDIT.
+
+package workflow
+
+import (
+	"errors"
+
+	"github.com/SSHcom/privx-sdk-go/v2/api/filters"
+	"github.com/SSHcom/privx-sdk-go/v2/api/response"
+)
+
+// Service is the interface of WorkflowEngine client, it allows substitution of
+// the client in tests, see Fake.
+type Service interface {
+	// CreateRequest create request.
+	CreateRequest(request *AccessRequest) (response.Identifier, error)
+	// CreateWorkflow create workflow.
+	CreateWorkflow(workflow *Workflow) (response.Identifier, error)
+	// DeleteRequest delete request.
+	DeleteRequest(requestID string) error
+	// DeleteWorkflow delete a workflow.
+	DeleteWorkflow(workflowID string) error
+	// GetRequest get request by id.
+	GetRequest(requestID string) (*AccessRequest, error)
+	// GetRequests get the request queue for the user
+	GetRequests(opts ...filters.Option) (*response.ResultSet[AccessRequest], error)
+	// GetSettings get settings for workflow engine.
+	GetSettings() (*WorkflowSettings, error)
+	// GetWorkflow get workflow by id.
+	GetWorkflow(workflowID string) (*Workflow, error)
+	// GetWorkflows get workflows.
+	GetWorkflows(opts ...filters.Option) (*response.ResultSet[Workflow], error)
+	// RevokeTargetRole revoke target role in request from target user.
+	RevokeTargetRole(requestID string) error
+	// SearchRequests search access requests
+	SearchRequests(search *AccessRequestSearch, opts ...filters.Option) (*response.ResultSet[AccessRequest], error)
+	// Status get workflow engine microservice status.
+	Status() (*response.ServiceStatus, error)
+	// TestSMTP test SMTP settings.
+	TestSMTP(settings *WorkflowSettings) (SMTPResponse, error)
+	// UpdateDecisionOnRequest update a request decision in queue.
+	UpdateDecisionOnRequest(requestID string, request Decision) error
+	// UpdateSettings update settings for workflow engine.
+	UpdateSettings(settings *WorkflowSettings) error
+	// UpdateWorkflow update workflow.
+	UpdateWorkflow(workflowID string, workflow *Workflow) error
+}
+
+var (
+	_ Service = (*WorkflowEngine)(nil)
+	_ Service = (*Fake)(nil)
+)
+
+// Fake is a fake of WorkflowEngine client. Methods delegate to the corresponding
+// function fields, undefined functions return an error.
+type Fake struct {
+	CreateRequestFunc           func(*AccessRequest) (response.Identifier, error)
+	CreateWorkflowFunc          func(*Workflow) (response.Identifier, error)
+	DeleteRequestFunc           func(string) error
+	DeleteWorkflowFunc          func(string) error
+	GetRequestFunc              func(string) (*AccessRequest, error)
+	GetRequestsFunc             func(...filters.Option) (*response.ResultSet[AccessRequest], error)
+	GetSettingsFunc             func() (*WorkflowSettings, error)
+	GetWorkflowFunc             func(string) (*Workflow, error)
+	GetWorkflowsFunc            func(...filters.Option) (*response.ResultSet[Workflow], error)
+	RevokeTargetRoleFunc        func(string) error
+	SearchRequestsFunc          func(*AccessRequestSearch, ...filters.Option) (*response.ResultSet[AccessRequest], error)
+	StatusFunc                  func() (*response.ServiceStatus, error)
+	TestSMTPFunc                func(*WorkflowSettings) (SMTPResponse, error)
+	UpdateDecisionOnRequestFunc func(string, Decision) error
+	UpdateSettingsFunc          func(*WorkflowSettings) error
+	UpdateWorkflowFunc          func(string, *Workflow) error
+}
+
+// CreateRequest calls CreateRequestFunc.
+func (f *Fake) CreateRequest(request *AccessRequest) (response.Identifier, error) {
+	if f.CreateRequestFunc == nil {
+		var r0 response.Identifier
+		return r0, errors.New("workflow: Fake.CreateRequest is not implemented")
+	}
+	return f.CreateRequestFunc(request)
+}
+
+// CreateWorkflow calls CreateWorkflowFunc.
+func (f *Fake) CreateWorkflow(workflow *Workflow) (response.Identifier, error) {
+	if f.CreateWorkflowFunc == nil {
+		var r0 response.Identifier
+		return r0, errors.New("workflow: Fake.CreateWorkflow is not implemented")
+	}
+	return f.CreateWorkflowFunc(workflow)
+}
+
+// DeleteRequest calls DeleteRequestFunc.
+func (f *Fake) DeleteRequest(requestID string) error {
+	if f.DeleteRequestFunc == nil {
+		return errors.New("workflow: Fake.DeleteRequest is not implemented")
+	}
+	return f.DeleteRequestFunc(requestID)
+}
+
+// DeleteWorkflow calls DeleteWorkflowFunc.
+func (f *Fake) DeleteWorkflow(workflowID string) error {
+	if f.DeleteWorkflowFunc == nil {
+		return errors.New("workflow: Fake.DeleteWorkflow is not implemented")
+	}
+	return f.DeleteWorkflowFunc(workflowID)
+}
+
+// GetRequest calls GetRequestFunc.
+func (f *Fake) GetRequest(requestID string) (*AccessRequest, error) {
+	if f.GetRequestFunc == nil {
+		var r0 *AccessRequest
+		return r0, errors.New("workflow: Fake.GetRequest is not implemented")
+	}
+	return f.GetRequestFunc(requestID)
+}
+
+// GetRequests calls GetRequestsFunc.
+func (f *Fake) GetRequests(opts ...filters.Option) (*response.ResultSet[AccessRequest], error) {
+	if f.GetRequestsFunc == nil {
+		var r0 *response.ResultSet[AccessRequest]
+		return r0, errors.New("workflow: Fake.GetRequests is not implemented")
+	}
+	return f.GetRequestsFunc(opts...)
+}
+
+// GetSettings calls GetSettingsFunc.
+func (f *Fake) GetSettings() (*WorkflowSettings, error) {
+	if f.GetSettingsFunc == nil {
+		var r0 *WorkflowSettings
+		return r0, errors.New("workflow: Fake.GetSettings is not implemented")
+	}
+	return f.GetSettingsFunc()
+}
+
+// GetWorkflow calls GetWorkflowFunc.
+func (f *Fake) GetWorkflow(workflowID string) (*Workflow, error) {
+	if f.GetWorkflowFunc == nil {
+		var r0 *Workflow
+		return r0, errors.New("workflow: Fake.GetWorkflow is not implemented")
+	}
+	return f.GetWorkflowFunc(workflowID)
+}
+
+// GetWorkflows calls GetWorkflowsFunc.
+func (f *Fake) GetWorkflows(opts ...filters.Option) (*response.ResultSet[Workflow], error) {
+	if f.GetWorkflowsFunc == nil {
+		var r0 *response.ResultSet[Workflow]
+		return r0, errors.New("workflow: Fake.GetWorkflows is not implemented")
+	}
+	return f.GetWorkflowsFunc(opts...)
+}
+
+// RevokeTargetRole calls RevokeTargetRoleFunc.
+func (f *Fake) RevokeTargetRole(requestID string) error {
+	if f.RevokeTargetRoleFunc == nil {
+		return errors.New("workflow: Fake.RevokeTargetRole is not implemented")
+	}
+	return f.RevokeTargetRoleFunc(requestID)
+}
+
+// SearchRequests calls SearchRequestsFunc.
+func (f *Fake) SearchRequests(search *AccessRequestSearch, opts ...filters.Option) (*response.ResultSet[AccessRequest], error) {
+	if f.SearchRequestsFunc == nil {
+		var r0 *response.ResultSet[AccessRequest]
+		return r0, errors.New("workflow: Fake.SearchRequests is not implemented")
+	}
+	return f.SearchRequestsFunc(search, opts...)
+}
+
+// Status calls StatusFunc.
+func (f *Fake) Status() (*response.ServiceStatus, error) {
+	if f.StatusFunc == nil {
+		var r0 *response.ServiceStatus
+		return r0, errors.New("workflow: Fake.Status is not implemented")
+	}
+	return f.StatusFunc()
+}
+
+// TestSMTP calls TestSMTPFunc.
+func (f *Fake) TestSMTP(settings *WorkflowSettings) (SMTPResponse, error) {
+	if f.TestSMTPFunc == nil {
+		var r0 SMTPResponse
+		return r0, errors.New("workflow: Fake.TestSMTP is not implemented")
+	}
+	return f.TestSMTPFunc(settings)
+}
+
+// UpdateDecisionOnRequest calls UpdateDecisionOnRequestFunc.
+func (f *Fake) UpdateDecisionOnRequest(requestID string, request Decision) error {
+	if f.UpdateDecisionOnRequestFunc == nil {
+		return errors.New("workflow: Fake.UpdateDecisionOnRequest is not implemented")
+	}
+	return f.UpdateDecisionOnRequestFunc(requestID, request)
+}
+
+// UpdateSettings calls UpdateSettingsFunc.
+func (f *Fake) UpdateSettings(settings *WorkflowSettings) error {
+	if f.UpdateSettingsFunc == nil {
+		return errors.New("workflow: Fake.UpdateSettings is not implemented")
+	}
+	return f.UpdateSettingsFunc(settings)
+}
+
+// UpdateWorkflow calls UpdateWorkflowFunc.
+func (f *Fake) UpdateWorkflow(workflowID string, workflow *Workflow) error {
+	if f.UpdateWorkflowFunc == nil {
+		return errors.New("workflow: Fake.UpdateWorkflow is not implemented")
+	}
+	return f.UpdateWorkflowFunc(workflowID, workflow)
+}
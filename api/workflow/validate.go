@@ -0,0 +1,26 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package workflow
+
+import "fmt"
+
+// Validate checks that decision either approves or denies the request step.
+func (d *Decision) Validate() error {
+	if d.Step < 0 {
+		return fmt.Errorf("invalid decision: negative step %d", d.Step)
+	}
+
+	switch d.Decision {
+	case DecisionApproved, DecisionDenied:
+	case "":
+		return fmt.Errorf("invalid decision: decision is required")
+	default:
+		return fmt.Errorf("invalid decision: unknown decision %q", d.Decision)
+	}
+
+	return nil
+}
@@ -2,4 +2,10 @@ module github.com/SSHcom/privx-sdk-go/v2
 
 go 1.25
 
-require github.com/BurntSushi/toml v1.3.2
+require (
+	github.com/BurntSushi/toml v1.3.2
+	golang.org/x/crypto v0.43.0
+	golang.org/x/oauth2 v0.30.0
+	golang.org/x/sys v0.37.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1
+)
@@ -0,0 +1,348 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+// Command mockgen generates Service interface and Fake implementation from
+// the method set of service client. The client is the type returned by New
+// constructor of the package. It is executed by go generate in the package
+// directory
+//
+//	//go:generate go run github.com/SSHcom/privx-sdk-go/v2/internal/mockgen
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const output = "service.go"
+
+// method is exported method of the client
+type method struct {
+	name    string
+	doc     []string
+	params  []param
+	results []string
+}
+
+// param is a named method parameter
+type param struct {
+	name     string
+	typ      string
+	variadic bool
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("mockgen: ")
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != output
+	}, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if len(pkgs) != 1 {
+		log.Fatalf("expected single package, found %d", len(pkgs))
+	}
+
+	for name, pkg := range pkgs {
+		src, err := generate(fset, name, pkg)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if err := os.WriteFile(output, src, 0644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// generate renders interface and fake of the package client
+func generate(fset *token.FileSet, name string, pkg *ast.Package) ([]byte, error) {
+	client := clientType(pkg)
+	if client == "" {
+		return nil, fmt.Errorf("package %s: constructor New not found", name)
+	}
+
+	imports := map[string]string{}
+	var methods []method
+
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || !fn.Name.IsExported() || receiver(fn) != client {
+				continue
+			}
+
+			for _, sel := range selectors(fn.Type) {
+				path, err := importPath(file, sel)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", fn.Name.Name, err)
+				}
+				imports[sel] = path
+			}
+
+			methods = append(methods, newMethod(fset, fn))
+		}
+	}
+
+	sort.Slice(methods, func(i, j int) bool { return methods[i].name < methods[j].name })
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by mockgen from %s method set; DO NOT EDIT.\n\n", client)
+	fmt.Fprintf(&b, "package %s\n\n", name)
+
+	b.WriteString("import (\n\t\"errors\"\n\n")
+	names := make([]string, 0, len(imports))
+	for sel := range imports {
+		names = append(names, sel)
+	}
+	sort.Strings(names)
+	for _, sel := range names {
+		fmt.Fprintf(&b, "\t%q\n", imports[sel])
+	}
+	b.WriteString(")\n\n")
+
+	fmt.Fprintf(&b, "// Service is the interface of %s client, it allows substitution of\n", client)
+	fmt.Fprintf(&b, "// the client in tests, see Fake.\n")
+	b.WriteString("type Service interface {\n")
+	for _, m := range methods {
+		for _, line := range m.doc {
+			fmt.Fprintf(&b, "\t// %s\n", line)
+		}
+		fmt.Fprintf(&b, "\t%s%s\n", m.name, m.signatureNamed())
+	}
+	b.WriteString("}\n\n")
+
+	fmt.Fprintf(&b, "var (\n\t_ Service = (*%s)(nil)\n\t_ Service = (*Fake)(nil)\n)\n\n", client)
+
+	fmt.Fprintf(&b, "// Fake is a fake of %s client. Methods delegate to the corresponding\n", client)
+	b.WriteString("// function fields, undefined functions return an error.\n")
+	b.WriteString("type Fake struct {\n")
+	for _, m := range methods {
+		fmt.Fprintf(&b, "\t%sFunc func%s\n", m.name, m.signature())
+	}
+	b.WriteString("}\n")
+
+	for _, m := range methods {
+		b.WriteString("\n")
+		fmt.Fprintf(&b, "// %s calls %sFunc.\n", m.name, m.name)
+		fmt.Fprintf(&b, "func (f *Fake) %s%s {\n", m.name, m.signatureNamed())
+		fmt.Fprintf(&b, "\tif f.%sFunc == nil {\n", m.name)
+		m.writeFallback(&b, name)
+		b.WriteString("\t}\n")
+		if len(m.results) > 0 {
+			fmt.Fprintf(&b, "\treturn f.%sFunc(%s)\n", m.name, m.args())
+		} else {
+			fmt.Fprintf(&b, "\tf.%sFunc(%s)\n", m.name, m.args())
+		}
+		b.WriteString("}\n")
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%w\n%s", err, b.String())
+	}
+	return src, nil
+}
+
+// clientType returns name of type constructed by New
+func clientType(pkg *ast.Package) string {
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv != nil || fn.Name.Name != "New" || fn.Type.Results == nil {
+				continue
+			}
+
+			if star, ok := fn.Type.Results.List[0].Type.(*ast.StarExpr); ok {
+				if ident, ok := star.X.(*ast.Ident); ok {
+					return ident.Name
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// receiver returns type name of method receiver
+func receiver(fn *ast.FuncDecl) string {
+	typ := fn.Recv.List[0].Type
+	if star, ok := typ.(*ast.StarExpr); ok {
+		typ = star.X
+	}
+	if ident, ok := typ.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// selectors returns package names referred by function type
+func selectors(fn *ast.FuncType) []string {
+	var names []string
+	ast.Inspect(fn, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok {
+				names = append(names, ident.Name)
+			}
+		}
+		return true
+	})
+	return names
+}
+
+// importPath resolves package name to import path of the file
+func importPath(file *ast.File, name string) (string, error) {
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return "", err
+		}
+
+		alias := path[strings.LastIndex(path, "/")+1:]
+		if spec.Name != nil {
+			alias = spec.Name.Name
+		}
+
+		if alias == name {
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("import of %s not found", name)
+}
+
+// newMethod collects signature and documentation of the method
+func newMethod(fset *token.FileSet, fn *ast.FuncDecl) method {
+	m := method{name: fn.Name.Name}
+
+	if fn.Doc != nil {
+		for _, line := range strings.Split(strings.TrimSpace(fn.Doc.Text()), "\n") {
+			if !strings.HasPrefix(line, "MARK:") {
+				m.doc = append(m.doc, line)
+			}
+		}
+	}
+
+	for _, field := range fn.Type.Params.List {
+		typ := field.Type
+		variadic := false
+		if ellipsis, ok := typ.(*ast.Ellipsis); ok {
+			typ, variadic = ellipsis.Elt, true
+		}
+
+		names := field.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{{Name: "_"}}
+		}
+
+		for _, ident := range names {
+			name := ident.Name
+			if name == "_" {
+				name = fmt.Sprintf("arg%d", len(m.params))
+			}
+			m.params = append(m.params, param{name: name, typ: expr(fset, typ), variadic: variadic})
+		}
+	}
+
+	if fn.Type.Results != nil {
+		for _, field := range fn.Type.Results.List {
+			n := len(field.Names)
+			if n == 0 {
+				n = 1
+			}
+			for i := 0; i < n; i++ {
+				m.results = append(m.results, expr(fset, field.Type))
+			}
+		}
+	}
+
+	return m
+}
+
+// expr renders expression as source code
+func expr(fset *token.FileSet, e ast.Expr) string {
+	var b bytes.Buffer
+	printer.Fprint(&b, fset, e)
+	return b.String()
+}
+
+// signature renders parameter and result types
+func (m method) signature() string {
+	params := make([]string, len(m.params))
+	for i, p := range m.params {
+		params[i] = p.kind()
+	}
+	return "(" + strings.Join(params, ", ") + ")" + m.resultList()
+}
+
+// signatureNamed renders named parameters and result types
+func (m method) signatureNamed() string {
+	params := make([]string, len(m.params))
+	for i, p := range m.params {
+		params[i] = p.name + " " + p.kind()
+	}
+	return "(" + strings.Join(params, ", ") + ")" + m.resultList()
+}
+
+func (m method) resultList() string {
+	switch len(m.results) {
+	case 0:
+		return ""
+	case 1:
+		return " " + m.results[0]
+	}
+	return " (" + strings.Join(m.results, ", ") + ")"
+}
+
+// args renders call arguments
+func (m method) args() string {
+	args := make([]string, len(m.params))
+	for i, p := range m.params {
+		args[i] = p.name
+		if p.variadic {
+			args[i] += "..."
+		}
+	}
+	return strings.Join(args, ", ")
+}
+
+// writeFallback renders return of zero values and not implemented error
+func (m method) writeFallback(b *bytes.Buffer, pkg string) {
+	if len(m.results) == 0 {
+		b.WriteString("\t\treturn\n")
+		return
+	}
+
+	values := make([]string, len(m.results))
+	for i, typ := range m.results {
+		if typ == "error" {
+			values[i] = fmt.Sprintf("errors.New(\"%s: Fake.%s is not implemented\")", pkg, m.name)
+			continue
+		}
+		fmt.Fprintf(b, "\t\tvar r%d %s\n", i, typ)
+		values[i] = fmt.Sprintf("r%d", i)
+	}
+	fmt.Fprintf(b, "\t\treturn %s\n", strings.Join(values, ", "))
+}
+
+func (p param) kind() string {
+	if p.variadic {
+		return "..." + p.typ
+	}
+	return p.typ
+}
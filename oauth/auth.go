@@ -1,7 +1,9 @@
 package oauth
 
 import (
+	"context"
 	"strings"
+	"sync"
 
 	"github.com/SSHcom/privx-sdk-go/v2/restapi"
 )
@@ -21,11 +23,28 @@ of access/secret key is provided. It allows usage of username and password
 * Client Access Key, see oauth.Access(...)
 * Client Secret Key, see oauth.Secret(...)
 
-3. Finally, it falls back explicit token token definition
+3. OAuth2 Client Credentials Grant strategy is used when only the client
+secret digest is provided, see oauth.Digest(...)
+
+4. Finally, it falls back explicit token token definition
+
+Credentials supplied by providers, see oauth.UseProvider(...), are queried
+lazily if access/secret keys are not defined explicitly. The strategy is
+chosen on the first request and again whenever the provider supplies
+different credentials, errors of the provider are returned by the authorizer.
 */
 func With(client restapi.Connector, opts ...Option) restapi.Authorizer {
 	auth := newAuth(client, opts...)
 
+	if auth.provider != nil && (auth.access == "" || auth.secret == "") {
+		return &tAuthLazy{tAuth: auth}
+	}
+
+	return strategy(auth)
+}
+
+// strategy chooses auth strategy using credentials of authorizer
+func strategy(auth *tAuth) ContextAuthorizer {
 	if strings.HasPrefix(auth.secret, "Bearer") {
 		return &tAuthExplicit{auth.secret}
 	}
@@ -38,5 +57,52 @@ func With(client restapi.Connector, opts ...Option) restapi.Authorizer {
 		return &tAuthCode{tAuth: auth}
 	}
 
+	if auth.access == "" && auth.secret == "" && auth.digest != "" {
+		return &tAuthClientCredentials{tAuth: auth}
+	}
+
 	return &tAuthExplicit{auth.secret}
 }
+
+// tAuthLazy chooses auth strategy once credentials are supplied by provider
+type tAuthLazy struct {
+	*tAuth
+	mu       sync.Mutex
+	strategy ContextAuthorizer
+	chosen   Credential
+}
+
+func (auth *tAuthLazy) AccessToken() (string, error) {
+	return auth.AccessTokenContext(context.Background())
+}
+
+func (auth *tAuthLazy) AccessTokenContext(ctx context.Context) (string, error) {
+	s, err := auth.resolve()
+	if err != nil {
+		return "", err
+	}
+
+	return s.AccessTokenContext(ctx)
+}
+
+// resolve queries credentials from provider until they are supplied and
+// chooses strategy again if credentials are changed
+func (auth *tAuthLazy) resolve() (ContextAuthorizer, error) {
+	auth.mu.Lock()
+	defer auth.mu.Unlock()
+
+	if auth.strategy == nil {
+		if _, err := auth.resolveCredential(false); err != nil {
+			return nil, err
+		}
+	}
+
+	auth.tAuth.mu.Lock()
+	if cred := *auth.credential; auth.strategy == nil || cred != auth.chosen {
+		auth.strategy = strategy(auth.tAuth)
+		auth.chosen = cred
+	}
+	auth.tAuth.mu.Unlock()
+
+	return auth.strategy, nil
+}
@@ -7,6 +7,7 @@
 package oauth
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -37,11 +38,23 @@ func WithClientID(client restapi.Connector, opts ...Option) restapi.Authorizer {
 	return &tAuthPassword{tAuth: newAuth(client, opts...)}
 }
 
-func (auth *tAuthPassword) AccessToken() (token string, err error) {
-	if err = auth.synchronized(auth.grantPasswordCredentials); err == nil {
-		token = fmt.Sprintf("Bearer %s", auth.token.AccessToken)
+func (auth *tAuthPassword) AccessToken() (string, error) {
+	return auth.AccessTokenContext(context.Background())
+}
+
+func (auth *tAuthPassword) AccessTokenContext(ctx context.Context) (string, error) {
+	token, err := auth.synchronized(ctx, auth.getAccessToken)
+	if err != nil {
+		return "", err
 	}
-	return
+
+	return fmt.Sprintf("Bearer %s", token.AccessToken), nil
+}
+
+func (auth *tAuthPassword) getAccessToken() error {
+	return auth.withCredential(func() error {
+		return auth.grant(GrantPassword, auth.grantPasswordCredentials)
+	})
 }
 
 func (auth *tAuthPassword) grantPasswordCredentials() error {
@@ -56,6 +69,7 @@ func (auth *tAuthPassword) grantPasswordCredentials() error {
 
 	_, err := auth.client.
 		URL("/auth/api/v1/oauth/token").
+		Context(auth.grantCtx).
 		Header("Content-Type", "application/x-www-form-urlencoded").
 		Header("Authorization", "Basic "+auth.digest).
 		CookieJar(auth.cookieJar).
@@ -0,0 +1,89 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package oauth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/SSHcom/privx-sdk-go/v2/restapi"
+)
+
+type tAuthClientCredentials struct{ *tAuth }
+
+/*
+WithClientCredentials executes OAuth2 Client Credentials Grant
+It uses OAuth client id/secret of API client to authenticate the client,
+access/secret key pair is not required
+
+	auth := oauth.WithClientCredentials(
+		restapi.New(
+		restapi.BaseURL(url),
+	),
+		oauth.Digest(oauthAccess, oauthSecret),
+		oauth.Scope("privx-user"), # optional
+	)
+
+	return restapi.New(
+		restapi.Auth(auth()),
+		restapi.BaseURL(url),
+	)
+*/
+func WithClientCredentials(client restapi.Connector, opts ...Option) restapi.Authorizer {
+	return &tAuthClientCredentials{tAuth: newAuth(client, opts...)}
+}
+
+func (auth *tAuthClientCredentials) AccessToken() (string, error) {
+	return auth.AccessTokenContext(context.Background())
+}
+
+func (auth *tAuthClientCredentials) AccessTokenContext(ctx context.Context) (string, error) {
+	token, err := auth.synchronized(ctx, auth.getAccessToken)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("Bearer %s", token.AccessToken), nil
+}
+
+func (auth *tAuthClientCredentials) getAccessToken() error {
+	return auth.withCredential(func() error {
+		return auth.grant(GrantClientCredentials, auth.grantClientCredentials)
+	})
+}
+
+func (auth *tAuthClientCredentials) grantClientCredentials() error {
+	auth.token = nil
+
+	if auth.digest == "" {
+		return errors.New("client credentials grant requires OAuth client id/secret, see oauth.Digest(...)")
+	}
+
+	request := reqAccessTokenClient{
+		GrantType: "client_credentials",
+		Scope:     auth.scope,
+	}
+	var token AccessToken
+
+	_, err := auth.client.
+		URL("/auth/api/v1/oauth/token").
+		Context(auth.grantCtx).
+		Header("Content-Type", "application/x-www-form-urlencoded").
+		Header("Authorization", "Basic "+auth.digest).
+		CookieJar(auth.cookieJar).
+		Post(request, &token)
+
+	if err == nil {
+		token.notAfter = time.Now().Add(
+			time.Duration(token.ExpiresIn) * time.Second)
+	}
+	auth.token = &token
+
+	return err
+}
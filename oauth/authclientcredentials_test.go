@@ -0,0 +1,49 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package oauth
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SSHcom/privx-sdk-go/v2/restapi"
+)
+
+func TestClientCredentials(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id, secret, ok := r.BasicAuth()
+			r.ParseForm()
+
+			if !ok || id != "privx-external" || secret != "secret" ||
+				r.Form.Get("grant_type") != "client_credentials" ||
+				r.Form.Get("scope") != "privx-user privx-admin" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			json.NewEncoder(w).Encode(AccessToken{AccessToken: "token", ExpiresIn: 60})
+		}),
+	)
+	defer ts.Close()
+
+	auth := WithClientCredentials(
+		restapi.New(restapi.BaseURL(ts.URL)),
+		Digest("privx-external", "secret"),
+		Scope("privx-user", "privx-admin"),
+	)
+
+	token, err := auth.AccessToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "Bearer token" {
+		t.Errorf("unexpected token: %s", token)
+	}
+}
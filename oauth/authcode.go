@@ -7,6 +7,7 @@
 package oauth
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/base64"
 	"errors"
@@ -27,7 +28,9 @@ type tAuthCode struct{ *tAuth }
 
 /*
 WithCredential executes OAuth2 Authorization Code Grant
-It uses access/secret key pair to authenticate client
+It uses access/secret key pair to authenticate client. Accounts with MFA
+enabled require one-time code provider, see oauth.MFASeed(...) and
+oauth.MFACode(...)
 
 	auth := oauth.WithCredential(
 		restapi.New(
@@ -35,6 +38,7 @@ It uses access/secret key pair to authenticate client
 	),
 		oauth.Access(access),
 		oauth.Secret(secret),
+		oauth.MFASeed(seed), # optional
 	)
 
 	return restapi.New(
@@ -46,20 +50,28 @@ func WithCredential(client restapi.Connector, opts ...Option) restapi.Authorizer
 	return &tAuthCode{tAuth: newAuth(client, opts...)}
 }
 
-func (auth *tAuthCode) AccessToken() (token string, err error) {
-	if err = auth.synchronized(auth.getAccessToken); err == nil {
-		token = fmt.Sprintf("Bearer %s", auth.token.AccessToken)
+func (auth *tAuthCode) AccessToken() (string, error) {
+	return auth.AccessTokenContext(context.Background())
+}
+
+func (auth *tAuthCode) AccessTokenContext(ctx context.Context) (string, error) {
+	token, err := auth.synchronized(ctx, auth.getAccessToken)
+	if err != nil {
+		return "", err
 	}
-	return
+
+	return fmt.Sprintf("Bearer %s", token.AccessToken), nil
 }
 
 func (auth *tAuthCode) getAccessToken() error {
 	if auth.token != nil && auth.token.RefreshToken != "" {
-		if auth.authRefreshToken() == nil {
+		if auth.refresh(GrantAuthorizationCode) == nil {
 			return nil
 		}
 	}
-	return auth.grantAuthorizationCode()
+	return auth.withCredential(func() error {
+		return auth.grant(GrantAuthorizationCode, auth.grantAuthorizationCode)
+	})
 }
 
 func (auth *tAuthCode) grantAuthorizationCode() error {
@@ -107,6 +119,7 @@ func (auth *tAuthCode) authSession(challenge, method, state string) (string, err
 
 	head, err := auth.client.
 		URL("/auth/api/v1/oauth/authorize").
+		Context(auth.grantCtx).
 		Query(request).
 		CookieJar(auth.cookieJar).
 		Status(307)
@@ -130,21 +143,54 @@ func (auth *tAuthCode) authCredential(session, state string) (string, error) {
 		Token:  session,
 	}
 
-	var response struct {
-		Code  string `json:"code"`
-		State string `json:"state"`
-	}
+	var response resLogin
 
 	_, err := auth.client.
 		URL("/auth/api/v1/login").
+		Context(auth.grantCtx).
 		CookieJar(auth.cookieJar).
 		Post(request, &response)
 
+	if err == nil && response.MFARequired {
+		response, err = auth.authMFA(session)
+	}
+
+	if err != nil {
+		return "", err
+	}
+
 	if response.State != state {
 		return "", errors.New("invalid response state")
 	}
 
-	return response.Code, err
+	return response.Code, nil
+}
+
+// authMFA answers the MFA challenge with one-time code
+func (auth *tAuthCode) authMFA(session string) (resLogin, error) {
+	var response resLogin
+
+	if auth.mfaCode == nil {
+		return response, errors.New("MFA is required, define one-time code provider using oauth.MFASeed(...) or oauth.MFACode(...)")
+	}
+
+	code, err := auth.mfaCode()
+	if err != nil {
+		return response, fmt.Errorf("failed to get MFA code: %w", err)
+	}
+
+	request := reqMFACode{
+		Token: session,
+		Code:  code,
+	}
+
+	_, err = auth.client.
+		URL("/auth/api/v1/login/mfa").
+		Context(auth.grantCtx).
+		CookieJar(auth.cookieJar).
+		Post(request, &response)
+
+	return response, err
 }
 
 func (auth *tAuth) authAccessToken(code string, cv pkce.CodeVerifier) (*AccessToken, error) {
@@ -158,6 +204,7 @@ func (auth *tAuth) authAccessToken(code string, cv pkce.CodeVerifier) (*AccessTo
 
 	_, err := auth.client.
 		URL("/auth/api/v1/oauth/token").
+		Context(auth.grantCtx).
 		Header("Content-Type", "application/x-www-form-urlencoded").
 		CookieJar(auth.cookieJar).
 		Post(request, &token)
@@ -180,6 +227,7 @@ func (auth *tAuth) authRefreshToken() error {
 
 	_, err := auth.client.
 		URL("/auth/api/v1/oauth/token").
+		Context(auth.grantCtx).
 		Header("Content-Type", "application/x-www-form-urlencoded").
 		CookieJar(auth.cookieJar).
 		Post(request, &token)
@@ -7,6 +7,7 @@
 package oauth
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/SSHcom/privx-sdk-go/v2/restapi"
@@ -30,6 +31,10 @@ func (auth *tAuthExplicit) AccessToken() (string, error) {
 	return auth.string, nil
 }
 
+func (auth *tAuthExplicit) AccessTokenContext(ctx context.Context) (string, error) {
+	return auth.string, nil
+}
+
 // Deprecated: Use auth.CookieJar() instead.
 func (auth *tAuthExplicit) Cookie() string {
 	// Session cookies not supported for explicit auth
@@ -7,6 +7,7 @@
 package oauth
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -22,7 +23,8 @@ WithExchangeToken authenticate users using externally created JWT Token in excha
 		restapi.New(
 		restapi.BaseURL(url),
 	),
-		oauth.ExchangeToken(token), # required
+		oauth.ExchangeToken(token), # required, unless token source is defined
+		oauth.ExchangeTokenFile(path), # optional, re-read on every exchange
 		oauth.ExchangeScope("privx-user"), # optional
 		oauth.AuthClientId("privx-ui"), # optional
 	)
@@ -37,20 +39,25 @@ func WithExchangeToken(client restapi.Connector, opts ...Option) restapi.Authori
 }
 
 func (auth *tAuthTokenExchange) AccessToken() (string, error) {
-	if err := auth.synchronized(auth.getAccessToken); err != nil {
+	return auth.AccessTokenContext(context.Background())
+}
+
+func (auth *tAuthTokenExchange) AccessTokenContext(ctx context.Context) (string, error) {
+	token, err := auth.synchronized(ctx, auth.getAccessToken)
+	if err != nil {
 		return "", err
 	}
 
-	return fmt.Sprintf("Bearer %s", auth.token.AccessToken), nil
+	return fmt.Sprintf("Bearer %s", token.AccessToken), nil
 }
 
 func (auth *tAuthTokenExchange) getAccessToken() error {
 	if auth.token != nil && auth.token.RefreshToken != "" {
-		if auth.authRefreshToken() == nil {
+		if auth.refresh(GrantTokenExchange) == nil {
 			return nil
 		}
 	}
-	return auth.exchangeToken()
+	return auth.grant(GrantTokenExchange, auth.exchangeToken)
 }
 
 func (auth *tAuthTokenExchange) exchangeToken() error {
@@ -68,14 +75,23 @@ func (auth *tAuthTokenExchange) exchangeToken() error {
 func (auth *tAuth) authExchangeToken() (*AccessToken, error) {
 	var token AccessToken
 
+	jwt := auth.exchangeToken
+	if auth.tokenSource != nil {
+		var err error
+		if jwt, err = auth.tokenSource(); err != nil {
+			return nil, fmt.Errorf("failed to read exchange token: %w", err)
+		}
+	}
+
 	request := Token{
-		Token:    auth.exchangeToken,
+		Token:    jwt,
 		Scope:    auth.scope,
 		ClientId: auth.clientId,
 	}
 
 	_, err := auth.client.
 		URL("/auth/api/v1/token/login").
+		Context(auth.grantCtx).
 		CookieJar(auth.cookieJar).
 		Post(&request, &token)
 
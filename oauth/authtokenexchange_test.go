@@ -0,0 +1,64 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package oauth
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/SSHcom/privx-sdk-go/v2/restapi"
+)
+
+func TestExchangeTokenFile(t *testing.T) {
+	var exchanged []string
+
+	ts := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req Token
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			exchanged = append(exchanged, req.Token)
+			// zero lifetime forces exchange on every call
+			json.NewEncoder(w).Encode(AccessToken{AccessToken: req.Token})
+		}),
+	)
+	defer ts.Close()
+
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("jwt-1\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	auth := WithExchangeToken(
+		restapi.New(restapi.BaseURL(ts.URL)),
+		ExchangeToken("static"),
+		ExchangeTokenFile(path),
+	)
+
+	if _, err := auth.AccessToken(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(path, []byte("jwt-2\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	token, err := auth.AccessToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if token != "Bearer jwt-2" || len(exchanged) != 2 || exchanged[0] != "jwt-1" {
+		t.Errorf("token is not re-read: %s, %v", token, exchanged)
+	}
+}
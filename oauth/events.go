@@ -0,0 +1,106 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package oauth
+
+import "time"
+
+// EventType defines type of authorizer lifecycle event.
+type EventType string
+
+const (
+	// Enumerated values of authorizer lifecycle events.
+	EventGrantStarted     EventType = "grant_started"
+	EventGrantSucceeded   EventType = "grant_succeeded"
+	EventGrantFailed      EventType = "grant_failed"
+	EventRefreshSucceeded EventType = "refresh_succeeded"
+	EventRefreshFailed    EventType = "refresh_failed"
+	EventFallback         EventType = "fallback"
+	EventTokenExpired     EventType = "token_expired"
+	EventCloseFailed      EventType = "close_failed"
+)
+
+const (
+	// Enumerated values of OAuth2 grants reported by events.
+	GrantPassword          = "password"
+	GrantAuthorizationCode = "authorization_code"
+	GrantClientCredentials = "client_credentials"
+	GrantTokenExchange     = "token_exchange"
+	GrantRefreshToken      = "refresh_token"
+)
+
+// Event describes authorizer lifecycle event.
+type Event struct {
+	Type EventType
+	// Grant is the OAuth2 grant which caused the event.
+	Grant string
+	// Err is the failure reason of grant, refresh or close.
+	Err error
+	// Expiry is the expiry time of the access token, if known.
+	Expiry time.Time
+	Time   time.Time
+}
+
+// OnEvent setups hook for authorizer lifecycle events, e.g. for logging or
+// metrics. Hooks are called synchronously while the authorizer acquires the
+// access token, they shall not block or call the authorizer.
+func OnEvent(hook func(Event)) Option {
+	return func(auth *tAuth) *tAuth {
+		if hook != nil {
+			auth.hooks = append(auth.hooks, hook)
+		}
+		return auth
+	}
+}
+
+// EventChannel setups channel for authorizer lifecycle events. Events are
+// dropped if the channel is not ready to receive them.
+func EventChannel(ch chan<- Event) Option {
+	return OnEvent(func(event Event) {
+		select {
+		case ch <- event:
+		default:
+		}
+	})
+}
+
+// emit delivers the event to hooks
+func (auth *tAuth) emit(event Event) {
+	if len(auth.hooks) == 0 {
+		return
+	}
+
+	event.Time = time.Now()
+	for _, hook := range auth.hooks {
+		hook(event)
+	}
+}
+
+// grant executes the grant and reports its progress
+func (auth *tAuth) grant(grant string, f func() error) error {
+	auth.emit(Event{Type: EventGrantStarted, Grant: grant})
+
+	if err := f(); err != nil {
+		auth.emit(Event{Type: EventGrantFailed, Grant: grant, Err: err})
+		return err
+	}
+
+	auth.emit(Event{Type: EventGrantSucceeded, Grant: grant, Expiry: auth.token.notAfter})
+	return nil
+}
+
+// refresh exchanges refresh token and reports the outcome, fallback to the
+// grant is reported if refresh fails
+func (auth *tAuth) refresh(fallback string) error {
+	if err := auth.authRefreshToken(); err != nil {
+		auth.emit(Event{Type: EventRefreshFailed, Grant: GrantRefreshToken, Err: err})
+		auth.emit(Event{Type: EventFallback, Grant: fallback, Err: err})
+		return err
+	}
+
+	auth.emit(Event{Type: EventRefreshSucceeded, Grant: GrantRefreshToken, Expiry: auth.token.notAfter})
+	return nil
+}
@@ -0,0 +1,59 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package oauth
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/SSHcom/privx-sdk-go/v2/restapi"
+)
+
+func TestEvents(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/auth/api/v1/token/login":
+				// zero lifetime expires the token immediately
+				json.NewEncoder(w).Encode(AccessToken{AccessToken: "token", RefreshToken: "refresh"})
+			case "/auth/api/v1/oauth/token":
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"error_code": "INVALID_GRANT"}`))
+			}
+		}),
+	)
+	defer ts.Close()
+
+	var events []EventType
+	auth := WithExchangeToken(
+		restapi.New(restapi.BaseURL(ts.URL)),
+		ExchangeToken("jwt"),
+		OnEvent(func(e Event) { events = append(events, e.Type) }),
+	)
+
+	for i := 0; i < 2; i++ {
+		if _, err := auth.AccessToken(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expect := []EventType{
+		EventGrantStarted,
+		EventGrantSucceeded,
+		EventTokenExpired,
+		EventRefreshFailed,
+		EventFallback,
+		EventGrantStarted,
+		EventGrantSucceeded,
+	}
+	if !reflect.DeepEqual(events, expect) {
+		t.Errorf("unexpected events: %v", events)
+	}
+}
@@ -0,0 +1,152 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package oauth
+
+import (
+	"context"
+	"errors"
+	"net/http/cookiejar"
+	"time"
+)
+
+const (
+	// closeAttempts is number of attempts to close authorizer once the
+	// context of CloseOnDone is done
+	closeAttempts = 3
+)
+
+// logoutTimeout bounds attempts of CloseOnDone if grant timeout is not set
+var logoutTimeout = 30 * time.Second
+
+var (
+	// ErrClosed is returned by authorizer after it is closed.
+	ErrClosed = errors.New("oauth: authorizer is closed")
+	// ErrGrantTimeout is returned by authorizer if the grant is not completed
+	// within timeout, see GrantTimeout.
+	ErrGrantTimeout = errors.New("oauth: grant timeout")
+)
+
+/*
+Close terminates the authorizer session at PrivX and clears the session
+cookies. Authorizer returns ErrClosed after it is closed. Close waits for
+the in-flight grant and the logout within the grant timeout, it returns
+ErrGrantTimeout if the in-flight grant is not completed in time. Authorizers
+returned by the package implement io.Closer
+
+	if closer, ok := auth.(io.Closer); ok {
+		defer closer.Close()
+	}
+*/
+func (auth *tAuth) Close() error {
+	ctx, cancel := auth.deadline()
+	defer cancel()
+
+	return auth.close(ctx)
+}
+
+// close waits for the in-flight grant and logs out within the context
+func (auth *tAuth) close(ctx context.Context) error {
+	auth.mu.Lock()
+	for auth.inflight != nil {
+		call := auth.inflight
+		auth.mu.Unlock()
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return ErrGrantTimeout
+		}
+		auth.mu.Lock()
+	}
+	if auth.closed {
+		auth.mu.Unlock()
+		return nil
+	}
+	// concurrent callers wait for logout and receive ErrClosed
+	call := &tGrant{done: make(chan struct{}), err: ErrClosed}
+	auth.inflight = call
+	auth.grantCtx = ctx
+	auth.mu.Unlock()
+
+	err := auth.logout()
+
+	auth.mu.Lock()
+	auth.grantCtx = nil
+	auth.token = nil
+	auth.closed = true
+	if auth.cookieJar != nil {
+		// cookiejar does not support removal of cookies, the jar is replaced
+		auth.cookieJar, _ = cookiejar.New(nil)
+	}
+	auth.inflight = nil
+	auth.mu.Unlock()
+	close(call.done)
+
+	return err
+}
+
+// logout revokes the session of access token at PrivX
+func (auth *tAuth) logout() error {
+	if auth.token == nil {
+		return nil
+	}
+
+	// logout requires a valid access token, refresh it if possible
+	if auth.token.isInvalid() {
+		if auth.token.RefreshToken == "" || auth.authRefreshToken() != nil {
+			return nil
+		}
+	}
+
+	_, err := auth.client.
+		URL("/auth/api/v1/logout").
+		Context(auth.grantCtx).
+		Header("Authorization", "Bearer "+auth.token.AccessToken).
+		CookieJar(auth.cookieJar).
+		Post(nil)
+
+	return err
+}
+
+// closeOnDone starts a routine which closes the authorizer once context is
+// done. Close is attempted a bounded number of times within the grant or
+// logout timeout, the failure of the last attempt is reported with
+// EventCloseFailed.
+func (auth *tAuth) closeOnDone() {
+	if auth.done == nil {
+		return
+	}
+
+	timeout := auth.grantTimeout
+	if timeout <= 0 {
+		timeout = logoutTimeout
+	}
+
+	go func() {
+		<-auth.done.Done()
+
+		var err error
+		for i := 0; i < closeAttempts; i++ {
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			err = auth.close(ctx)
+			cancel()
+			// in-flight grant is cancelled by the grant timeout, close is
+			// retried after it
+			if err != ErrGrantTimeout {
+				break
+			}
+		}
+
+		if err != nil {
+			auth.emit(Event{Type: EventCloseFailed, Err: err})
+		}
+	}()
+}
+
+// Close is no-op for explicit token authorizer.
+func (auth *tAuthExplicit) Close() error {
+	return nil
+}
@@ -0,0 +1,177 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/SSHcom/privx-sdk-go/v2/restapi"
+)
+
+func mockLogout(logout *atomic.Int32) *httptest.Server {
+	return httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/auth/api/v1/oauth/token":
+				http.SetCookie(w, &http.Cookie{Name: "session", Value: "s"})
+				json.NewEncoder(w).Encode(AccessToken{AccessToken: "token", ExpiresIn: 60})
+			case "/auth/api/v1/logout":
+				if r.Header.Get("Authorization") != "Bearer token" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				logout.Add(1)
+			}
+		}),
+	)
+}
+
+func TestClose(t *testing.T) {
+	var logout atomic.Int32
+	ts := mockLogout(&logout)
+	defer ts.Close()
+
+	auth := WithClientID(
+		restapi.New(restapi.BaseURL(ts.URL)),
+		Access("access"), Secret("secret"), Digest("id", "secret"),
+		UseCookies(),
+	)
+
+	if _, err := auth.AccessToken(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := auth.(io.Closer).Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if logout.Load() != 1 {
+		t.Errorf("session is not logged out")
+	}
+
+	uri, _ := url.Parse(ts.URL)
+	jar := auth.(restapi.CookieJarProvider).CookieJar()
+	if cookies := jar.Cookies(uri); len(cookies) != 0 {
+		t.Errorf("cookie jar is not cleared: %v", cookies)
+	}
+
+	if _, err := auth.AccessToken(); !errors.Is(err, ErrClosed) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestCloseOnDone(t *testing.T) {
+	var logout atomic.Int32
+	ts := mockLogout(&logout)
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	auth := WithClientID(
+		restapi.New(restapi.BaseURL(ts.URL)),
+		Access("access"), Secret("secret"), Digest("id", "secret"),
+		CloseOnDone(ctx),
+	)
+
+	if _, err := auth.AccessToken(); err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for logout.Load() == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if logout.Load() != 1 {
+		t.Errorf("session is not logged out")
+	}
+}
+
+func TestCloseOnDoneFailure(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/auth/api/v1/oauth/token":
+				json.NewEncoder(w).Encode(AccessToken{AccessToken: "token", ExpiresIn: 60})
+			case "/auth/api/v1/logout":
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		}),
+	)
+	defer ts.Close()
+
+	events := make(chan Event, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	auth := WithClientID(
+		restapi.New(restapi.BaseURL(ts.URL)),
+		Access("access"), Secret("secret"), Digest("id", "secret"),
+		CloseOnDone(ctx), EventChannel(events),
+	)
+
+	if _, err := auth.AccessToken(); err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+
+	if event := waitEvent(t, events, EventCloseFailed); event.Err == nil {
+		t.Errorf("logout error is not reported")
+	}
+}
+
+func TestCloseOnDoneHangingGrant(t *testing.T) {
+	defer func(timeout time.Duration) { logoutTimeout = timeout }(logoutTimeout)
+	logoutTimeout = 20 * time.Millisecond
+
+	release := make(chan struct{})
+	var grants atomic.Int32
+	ts := mockGrant(&grants, func(w http.ResponseWriter) {
+		<-release
+	})
+	defer ts.Close()
+	defer close(release)
+
+	events := make(chan Event, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	auth := newPasswordAuth(ts.URL, CloseOnDone(ctx), EventChannel(events))
+
+	go auth.AccessToken()
+	for grants.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+
+	if event := waitEvent(t, events, EventCloseFailed); !errors.Is(event.Err, ErrGrantTimeout) {
+		t.Errorf("unexpected error: %v", event.Err)
+	}
+}
+
+// waitEvent waits for event of the type
+func waitEvent(t *testing.T, events <-chan Event, eventType EventType) Event {
+	t.Helper()
+
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case event := <-events:
+			if event.Type == eventType {
+				return event
+			}
+		case <-timeout:
+			t.Fatalf("%s event is not emitted", eventType)
+			return Event{}
+		}
+	}
+}
@@ -0,0 +1,72 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package oauth
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// totpStep is the time step of TOTP code, RFC 6238
+	totpStep = 30 * time.Second
+	// totpDigits is the length of TOTP code
+	totpDigits = 6
+)
+
+// MFACode setups provider of one-time code for MFA challenge, e.g. a callback
+// which prompts the code from user.
+func MFACode(provider func() (string, error)) Option {
+	return func(auth *tAuth) *tAuth {
+		if provider != nil {
+			auth.mfaCode = provider
+		}
+		return auth
+	}
+}
+
+// MFASeed setups TOTP seed, the authorizer generates one-time code for MFA
+// challenge from the seed. The seed is base32 encoded string as shown by PrivX
+// when MFA is enabled for the user.
+func MFASeed(seed string) Option {
+	return func(auth *tAuth) *tAuth {
+		if seed != "" {
+			auth.mfaCode = func() (string, error) {
+				return totp(seed, time.Now())
+			}
+		}
+		return auth
+	}
+}
+
+// totp generates time-based one-time password, RFC 6238
+func totp(seed string, t time.Time) (string, error) {
+	seed = strings.ToUpper(strings.ReplaceAll(seed, " ", ""))
+	key, err := base32.StdEncoding.
+		WithPadding(base32.NoPadding).
+		DecodeString(strings.TrimRight(seed, "="))
+	if err != nil {
+		return "", fmt.Errorf("invalid TOTP seed: %w", err)
+	}
+
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(t.Unix()/int64(totpStep.Seconds())))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	code := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", totpDigits, code%1000000), nil
+}
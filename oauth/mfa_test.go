@@ -0,0 +1,105 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package oauth
+
+import (
+	"encoding/base32"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/SSHcom/privx-sdk-go/v2/restapi"
+)
+
+func TestTOTP(t *testing.T) {
+	// RFC 6238 test vectors, truncated to 6 digits
+	seed := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+	for at, expect := range map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1234567890: "005924",
+	} {
+		code, err := totp(seed, time.Unix(at, 0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if code != expect {
+			t.Errorf("unexpected code at %d: %s", at, code)
+		}
+	}
+}
+
+func TestAuthCodeMFA(t *testing.T) {
+	var state string
+
+	ts := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/auth/api/v1/oauth/authorize":
+				state = r.URL.Query().Get("state")
+				w.Header().Set("Location", "/auth/login?token=session")
+				w.WriteHeader(http.StatusTemporaryRedirect)
+			case "/auth/api/v1/login":
+				json.NewEncoder(w).Encode(resLogin{MFARequired: true})
+			case "/auth/api/v1/login/mfa":
+				var req reqMFACode
+				json.NewDecoder(r.Body).Decode(&req)
+				if req.Token != "session" || req.Code != "123456" {
+					w.WriteHeader(http.StatusForbidden)
+					return
+				}
+				json.NewEncoder(w).Encode(resLogin{Code: "code", State: state})
+			case "/auth/api/v1/oauth/token":
+				json.NewEncoder(w).Encode(AccessToken{AccessToken: "token", ExpiresIn: 60})
+			}
+		}),
+	)
+	defer ts.Close()
+
+	auth := WithCredential(
+		restapi.New(restapi.BaseURL(ts.URL)),
+		Access("user"),
+		Secret("password"),
+		MFACode(func() (string, error) { return "123456", nil }),
+	)
+
+	token, err := auth.AccessToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "Bearer token" {
+		t.Errorf("unexpected token: %s", token)
+	}
+}
+
+func TestAuthCodeMFARequired(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/auth/api/v1/oauth/authorize":
+				w.Header().Set("Location", "/auth/login?token=session")
+				w.WriteHeader(http.StatusTemporaryRedirect)
+			case "/auth/api/v1/login":
+				json.NewEncoder(w).Encode(resLogin{MFARequired: true})
+			}
+		}),
+	)
+	defer ts.Close()
+
+	auth := WithCredential(
+		restapi.New(restapi.BaseURL(ts.URL)),
+		Access("user"),
+		Secret("password"),
+	)
+
+	if _, err := auth.AccessToken(); err == nil {
+		t.Error("authorizer is not failing")
+	}
+}
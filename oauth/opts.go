@@ -7,9 +7,12 @@
 package oauth
 
 import (
+	"context"
 	"encoding/base64"
 	"io"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/BurntSushi/toml"
 )
@@ -47,6 +50,36 @@ func ExchangeToken(token string) Option {
 	}
 }
 
+// ExchangeTokenSource setups a function which supplies externally created
+// JWT Token. The function is called every time the authorizer exchanges the
+// token, which allows usage of short-lived tokens, e.g. CI OIDC tokens.
+func ExchangeTokenSource(source func() (string, error)) Option {
+	return func(auth *tAuth) *tAuth {
+		if source != nil {
+			auth.tokenSource = source
+		}
+		return auth
+	}
+}
+
+// ExchangeTokenFile setups a file which contains externally created JWT Token.
+// The file is re-read every time the authorizer exchanges the token, e.g.
+// projected service account tokens of Kubernetes.
+func ExchangeTokenFile(path string) Option {
+	return func(auth *tAuth) *tAuth {
+		if path != "" {
+			auth.tokenSource = func() (string, error) {
+				data, err := os.ReadFile(path)
+				if err != nil {
+					return "", err
+				}
+				return strings.TrimSpace(string(data)), nil
+			}
+		}
+		return auth
+	}
+}
+
 // ExchangeScope setups access token scope e.g. privx-user
 func ExchangeScope(scope string) Option {
 	return func(auth *tAuth) *tAuth {
@@ -57,6 +90,17 @@ func ExchangeScope(scope string) Option {
 	}
 }
 
+// Scope setups access token scope of client credentials grant, multiple
+// scopes are space separated as defined by OAuth2.
+func Scope(scopes ...string) Option {
+	return func(auth *tAuth) *tAuth {
+		if scope := strings.Join(scopes, " "); scope != "" {
+			auth.scope = scope
+		}
+		return auth
+	}
+}
+
 // AuthClientId setups OAUTH client Id e.g. privx-ui
 func AuthClientId(clientId string) Option {
 	return func(auth *tAuth) *tAuth {
@@ -71,12 +115,17 @@ func AuthClientId(clientId string) Option {
 func Digest(oauthAccess, oauthSecret string) Option {
 	return func(auth *tAuth) *tAuth {
 		if oauthAccess != "" && oauthSecret != "" {
-			auth.digest = base64.StdEncoding.EncodeToString([]byte(oauthAccess + ":" + oauthSecret))
+			auth.digest = digestOf(oauthAccess, oauthSecret)
 		}
 		return auth
 	}
 }
 
+// digestOf encodes client secret digest
+func digestOf(oauthAccess, oauthSecret string) string {
+	return base64.StdEncoding.EncodeToString([]byte(oauthAccess + ":" + oauthSecret))
+}
+
 // UseConfigFile setup credential from tol file
 func UseConfigFile(path string) Option {
 	return func(auth *tAuth) *tAuth {
@@ -86,7 +135,9 @@ func UseConfigFile(path string) Option {
 			ClientID         string `toml:"api_client_id"`
 			ClientSecret     string `toml:"api_client_secret"`
 			ExchangeToken    string `toml:"exchange_token"`
+			ExchangeFile     string `toml:"exchange_token_file"`
 			ExchangeScope    string `toml:"exchange_scope"`
+			MFASeed          string `toml:"mfa_seed"`
 		}
 		var file struct {
 			Auth config
@@ -116,7 +167,9 @@ func UseConfigFile(path string) Option {
 		auth = Digest(file.Auth.AuthClientID, file.Auth.AuthClientSecret)(auth)
 		auth = AuthClientId(file.Auth.AuthClientID)(auth)
 		auth = ExchangeToken(file.Auth.ExchangeToken)(auth)
+		auth = ExchangeTokenFile(file.Auth.ExchangeFile)(auth)
 		auth = ExchangeScope(file.Auth.ExchangeScope)(auth)
+		auth = MFASeed(file.Auth.MFASeed)(auth)
 		return auth
 	}
 }
@@ -142,10 +195,18 @@ func UseEnvironment() Option {
 			auth = ExchangeToken(token)(auth)
 		}
 
+		if path, ok := os.LookupEnv("PRIVX_EXCHANGE_TOKEN_FILE"); ok {
+			auth = ExchangeTokenFile(path)(auth)
+		}
+
 		if token, ok := os.LookupEnv("PRIVX_EXCHANGE_SCOPE"); ok {
 			auth = ExchangeScope(token)(auth)
 		}
 
+		if seed, ok := os.LookupEnv("PRIVX_API_MFA_SEED"); ok {
+			auth = MFASeed(seed)(auth)
+		}
+
 		if authAccess, ok := os.LookupEnv("PRIVX_API_OAUTH_CLIENT_ID"); ok {
 			auth = AuthClientId(authAccess)(auth)
 
@@ -158,6 +219,43 @@ func UseEnvironment() Option {
 	}
 }
 
+// UseProvider setup credential providers. Authorizer queries providers
+// lazily before the grant and again if the grant fails. Multiple providers
+// are chained, see ChainProvider.
+func UseProvider(providers ...Provider) Option {
+	return func(auth *tAuth) *tAuth {
+		switch len(providers) {
+		case 0:
+		case 1:
+			auth.provider = providers[0]
+		default:
+			auth.provider = ChainProvider(providers...)
+		}
+		return auth
+	}
+}
+
+// CloseOnDone closes the authorizer when the context is done. Failure to
+// close is reported with EventCloseFailed.
+func CloseOnDone(ctx context.Context) Option {
+	return func(auth *tAuth) *tAuth {
+		auth.done = ctx
+		return auth
+	}
+}
+
+// GrantTimeout caps the grant of access token. Requests of the grant are
+// cancelled once the timeout is exceeded and the caller receives
+// ErrGrantTimeout. The timeout bounds Close as well.
+func GrantTimeout(timeout time.Duration) Option {
+	return func(auth *tAuth) *tAuth {
+		if timeout > 0 {
+			auth.grantTimeout = timeout
+		}
+		return auth
+	}
+}
+
 func UseCookies() Option {
 	return func(auth *tAuth) *tAuth {
 		auth.useCookies = true
@@ -0,0 +1,256 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package oauth
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/SSHcom/privx-sdk-go/v2/restapi"
+)
+
+// Identity defines credentials of PrivX user or API client on behalf of
+// which the requests are made. Either API client credentials or externally
+// created JWT Token shall be defined.
+type Identity struct {
+	Access            string
+	Secret            string
+	OAuthClientID     string
+	OAuthClientSecret string
+	ExchangeToken     string
+	ExchangeScope     string
+}
+
+// ClientIdentity defines identity of API client.
+func ClientIdentity(access, secret, oauthAccess, oauthSecret string) Identity {
+	return Identity{
+		Access:            access,
+		Secret:            secret,
+		OAuthClientID:     oauthAccess,
+		OAuthClientSecret: oauthSecret,
+	}
+}
+
+// ExchangeIdentity defines identity of user authenticated with external JWT Token.
+func ExchangeIdentity(token, scope string) Identity {
+	return Identity{ExchangeToken: token, ExchangeScope: scope}
+}
+
+// options returns authorizer options for the identity
+func (id Identity) options() []Option {
+	return []Option{
+		Access(id.Access),
+		Secret(id.Secret),
+		Digest(id.OAuthClientID, id.OAuthClientSecret),
+		AuthClientId(id.OAuthClientID),
+		ExchangeToken(id.ExchangeToken),
+		ExchangeScope(id.ExchangeScope),
+	}
+}
+
+// tPoolEntry is authorizer cached by the pool
+type tPoolEntry struct {
+	id   Identity
+	auth restapi.Authorizer
+}
+
+/*
+Pool caches authorizers of multiple identities, e.g. users of multi-tenant
+service. Least recently used authorizers are closed once the pool size is
+exceeded. Authorizers and connectors of the pool are derived from the parent
+client, therefore they share its HTTP transport.
+
+	curl := restapi.New(restapi.BaseURL(url))
+
+	pool := oauth.NewPool(curl, 100, oauth.UseCookies())
+	defer pool.Close()
+
+	hosts, err := hoststore.New(
+		pool.Connector(nil, oauth.ExchangeIdentity(jwt, "privx-user")),
+	).GetHosts()
+*/
+type Pool struct {
+	mu      sync.Mutex
+	parent  restapi.Connector
+	client  restapi.Connector
+	opts    []Option
+	size    int
+	entries map[Identity]*list.Element
+	lru     *list.List
+	closed  bool
+}
+
+// NewPool creates a pool of authorizers, options are applied to every
+// authorizer before options of the identity. Authorizers request tokens
+// using unauthenticated client derived from the parent client.
+func NewPool(parent restapi.Connector, size int, opts ...Option) *Pool {
+	if size < 1 {
+		size = 1
+	}
+
+	return &Pool{
+		parent:  parent,
+		client:  restapi.Derive(parent, restapi.Auth(nil)),
+		opts:    opts,
+		size:    size,
+		entries: map[Identity]*list.Element{},
+		lru:     list.New(),
+	}
+}
+
+// Authorizer returns authorizer of the identity.
+func (pool *Pool) Authorizer(id Identity) restapi.Authorizer {
+	return &tPoolAuth{pool: pool, id: id}
+}
+
+// Connector returns client which makes requests on behalf of the identity.
+// The client shares HTTP transport and configuration with the parent client,
+// nil parent stands for the parent client of the pool.
+func (pool *Pool) Connector(parent restapi.Connector, id Identity) restapi.Connector {
+	if parent == nil {
+		parent = pool.parent
+	}
+	return restapi.Derive(parent, restapi.Auth(pool.Authorizer(id)))
+}
+
+// Len returns number of cached authorizers.
+func (pool *Pool) Len() int {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	return pool.lru.Len()
+}
+
+// Close closes all cached authorizers. Authorizers of the pool return
+// ErrClosed after it is closed.
+func (pool *Pool) Close() error {
+	pool.mu.Lock()
+	pool.closed = true
+	var evicted []restapi.Authorizer
+	for e := pool.lru.Front(); e != nil; e = e.Next() {
+		evicted = append(evicted, e.Value.(*tPoolEntry).auth)
+	}
+	pool.entries = map[Identity]*list.Element{}
+	pool.lru.Init()
+	pool.mu.Unlock()
+
+	var errs []error
+	for _, auth := range evicted {
+		if closer, ok := auth.(io.Closer); ok {
+			errs = append(errs, closer.Close())
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// get returns cached authorizer of the identity or creates a new one
+func (pool *Pool) get(id Identity) (restapi.Authorizer, error) {
+	pool.mu.Lock()
+
+	if pool.closed {
+		pool.mu.Unlock()
+		return nil, ErrClosed
+	}
+
+	if e, ok := pool.entries[id]; ok {
+		pool.lru.MoveToFront(e)
+		pool.mu.Unlock()
+		return e.Value.(*tPoolEntry).auth, nil
+	}
+
+	opts := append(append([]Option{}, pool.opts...), id.options()...)
+
+	var auth restapi.Authorizer
+	if id.ExchangeToken != "" {
+		auth = WithExchangeToken(pool.client, opts...)
+	} else {
+		auth = With(pool.client, opts...)
+	}
+
+	pool.entries[id] = pool.lru.PushFront(&tPoolEntry{id: id, auth: auth})
+
+	var evicted []restapi.Authorizer
+	for pool.lru.Len() > pool.size {
+		entry := pool.lru.Remove(pool.lru.Back()).(*tPoolEntry)
+		delete(pool.entries, entry.id)
+		evicted = append(evicted, entry.auth)
+	}
+	pool.mu.Unlock()
+
+	if len(evicted) > 0 {
+		go func() {
+			for _, auth := range evicted {
+				if closer, ok := auth.(io.Closer); ok {
+					closer.Close()
+				}
+			}
+		}()
+	}
+
+	return auth, nil
+}
+
+// lookup returns cached authorizer of the identity, it neither creates nor
+// evicts authorizers
+func (pool *Pool) lookup(id Identity) restapi.Authorizer {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	if e, ok := pool.entries[id]; ok {
+		return e.Value.(*tPoolEntry).auth
+	}
+	return nil
+}
+
+// tPoolAuth is a handle of identity authorizer in the pool
+type tPoolAuth struct {
+	pool *Pool
+	id   Identity
+}
+
+func (auth *tPoolAuth) AccessToken() (string, error) {
+	return auth.AccessTokenContext(context.Background())
+}
+
+// AccessTokenContext returns access token of the identity. The authorizer
+// is re-created if it is evicted from the pool concurrently.
+func (auth *tPoolAuth) AccessTokenContext(ctx context.Context) (token string, err error) {
+	for i := 0; i < 2; i++ {
+		var a restapi.Authorizer
+		if a, err = auth.pool.get(auth.id); err != nil {
+			return
+		}
+		if ca, ok := a.(ContextAuthorizer); ok {
+			token, err = ca.AccessTokenContext(ctx)
+		} else {
+			token, err = a.AccessToken()
+		}
+		if !errors.Is(err, ErrClosed) {
+			return
+		}
+	}
+	return
+}
+
+// CookieJar returns cookie jar of the cached authorizer of the identity, nil
+// if the authorizer is not cached. The lookup does not create or evict
+// authorizers of the pool.
+func (auth *tPoolAuth) CookieJar() http.CookieJar {
+	if cjp, ok := auth.pool.lookup(auth.id).(restapi.CookieJarProvider); ok {
+		return cjp.CookieJar()
+	}
+	return nil
+}
+
+// Deprecated: Use auth.CookieJar() instead.
+func (auth *tPoolAuth) Cookie() string {
+	return ""
+}
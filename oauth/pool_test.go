@@ -0,0 +1,101 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package oauth
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/SSHcom/privx-sdk-go/v2/restapi"
+)
+
+func TestPool(t *testing.T) {
+	var logout atomic.Int32
+
+	ts := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/auth/api/v1/oauth/token":
+				r.ParseForm()
+				json.NewEncoder(w).Encode(AccessToken{
+					AccessToken: r.Form.Get("username"),
+					ExpiresIn:   60,
+				})
+			case "/auth/api/v1/logout":
+				logout.Add(1)
+			default:
+				w.Write([]byte(`{"id": "` + r.Header.Get("Authorization") + `"}`))
+			}
+		}),
+	)
+	defer ts.Close()
+
+	pool := NewPool(restapi.New(restapi.BaseURL(ts.URL)), 1)
+
+	whoami := func(id Identity) string {
+		var data struct {
+			ID string `json:"id"`
+		}
+		if _, err := pool.Connector(nil, id).URL("/").Get(&data); err != nil {
+			t.Fatal(err)
+		}
+		return data.ID
+	}
+
+	alice := ClientIdentity("alice", "secret", "id", "secret")
+	bob := ClientIdentity("bob", "secret", "id", "secret")
+
+	if id := whoami(alice); id != "Bearer alice" {
+		t.Errorf("unexpected identity: %s", id)
+	}
+	if id := whoami(bob); id != "Bearer bob" {
+		t.Errorf("unexpected identity: %s", id)
+	}
+	if pool.Len() != 1 {
+		t.Errorf("unexpected pool size: %d", pool.Len())
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for logout.Load() == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if logout.Load() != 1 {
+		t.Errorf("evicted authorizer is not closed")
+	}
+
+	if id := whoami(alice); id != "Bearer alice" {
+		t.Errorf("unexpected identity: %s", id)
+	}
+
+	if err := pool.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if pool.Len() != 0 {
+		t.Errorf("pool is not empty")
+	}
+	if _, err := pool.Authorizer(bob).AccessToken(); !errors.Is(err, ErrClosed) {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if pool.Len() != 0 {
+		t.Errorf("closed pool caches authorizers")
+	}
+}
+
+func TestPoolCookieJar(t *testing.T) {
+	pool := NewPool(restapi.New(restapi.BaseURL("http://localhost")), 1, UseCookies())
+	defer pool.Close()
+
+	auth := pool.Authorizer(ClientIdentity("alice", "secret", "id", "secret"))
+	if jar := auth.(restapi.CookieJarProvider).CookieJar(); jar != nil || pool.Len() != 0 {
+		t.Errorf("cookie jar lookup creates authorizer")
+	}
+}
@@ -0,0 +1,330 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package oauth
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+// Credential is a set of client secrets supplied by a credential provider.
+// Empty fields are considered as not provided.
+type Credential struct {
+	Access            string `toml:"api_client_id"`
+	Secret            string `toml:"api_client_secret"`
+	OAuthClientID     string `toml:"oauth_client_id"`
+	OAuthClientSecret string `toml:"oauth_client_secret"`
+}
+
+// complete checks if every credential field is defined
+func (c Credential) complete() bool {
+	return c.Access != "" && c.Secret != "" &&
+		c.OAuthClientID != "" && c.OAuthClientSecret != ""
+}
+
+// merge fills undefined fields of the credential from another one
+func (c Credential) merge(other Credential) Credential {
+	if c.Access == "" {
+		c.Access = other.Access
+	}
+	if c.Secret == "" {
+		c.Secret = other.Secret
+	}
+	if c.OAuthClientID == "" {
+		c.OAuthClientID = other.OAuthClientID
+	}
+	if c.OAuthClientSecret == "" {
+		c.OAuthClientSecret = other.OAuthClientSecret
+	}
+	return c
+}
+
+// set assigns credential field using config file key name
+func (c *Credential) set(key, value string) {
+	switch key {
+	case "api_client_id":
+		c.Access = value
+	case "api_client_secret":
+		c.Secret = value
+	case "oauth_client_id":
+		c.OAuthClientID = value
+	case "oauth_client_secret":
+		c.OAuthClientSecret = value
+	}
+}
+
+// credentialKeys lists config key names of credential fields
+var credentialKeys = []string{
+	"api_client_id",
+	"api_client_secret",
+	"oauth_client_id",
+	"oauth_client_secret",
+}
+
+// Provider supplies credentials to authorizer. Authorizer queries the
+// provider lazily before the first grant and again when a grant fails,
+// which allows rotation of secrets without restart of the application.
+type Provider interface {
+	Retrieve() (Credential, error)
+}
+
+// ProviderFunc is an adapter to use ordinary functions as Provider.
+type ProviderFunc func() (Credential, error)
+
+// Retrieve calls f()
+func (f ProviderFunc) Retrieve() (Credential, error) {
+	return f()
+}
+
+// StaticProvider supplies explicitly defined credentials.
+func StaticProvider(cred Credential) Provider {
+	return ProviderFunc(func() (Credential, error) { return cred, nil })
+}
+
+/*
+ChainProvider queries providers in the given order. Each provider fills
+only fields which are not yet defined by preceding providers, therefore
+secrets can be split across sources. Errors of individual providers are
+ignored unless the chain fails to supply the client access/secret pair.
+
+	oauth.UseProvider(
+		oauth.ChainProvider(
+			oauth.EnvProvider(),
+			oauth.DirProvider("/var/run/secrets/privx"),
+			oauth.CommandProvider("privx-credential-helper", "get"),
+		),
+	)
+*/
+func ChainProvider(providers ...Provider) Provider {
+	return ProviderFunc(func() (Credential, error) {
+		var (
+			cred Credential
+			errs []error
+		)
+
+		for _, provider := range providers {
+			c, err := provider.Retrieve()
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			cred = cred.merge(c)
+			if cred.complete() {
+				break
+			}
+		}
+
+		if cred.Access == "" || cred.Secret == "" {
+			errs = append([]error{errors.New("credential chain: access/secret not found")}, errs...)
+			return cred, errors.Join(errs...)
+		}
+
+		return cred, nil
+	})
+}
+
+// EnvProvider supplies credentials from environment variables, it uses
+// same variables as UseEnvironment.
+func EnvProvider() Provider {
+	return ProviderFunc(func() (Credential, error) {
+		var cred Credential
+
+		for _, key := range []string{"PRIVX_API_CLIENT_ID", "PRIVX_API_ACCESS_KEY"} {
+			if val, ok := os.LookupEnv(key); ok && val != "" {
+				cred.Access = val
+			}
+		}
+
+		for _, key := range []string{"PRIVX_API_CLIENT_SECRET", "PRIVX_API_SECRET_KEY"} {
+			if val, ok := os.LookupEnv(key); ok && val != "" {
+				cred.Secret = val
+			}
+		}
+
+		cred.OAuthClientID = os.Getenv("PRIVX_API_OAUTH_CLIENT_ID")
+		cred.OAuthClientSecret = os.Getenv("PRIVX_API_OAUTH_CLIENT_SECRET")
+
+		return cred, nil
+	})
+}
+
+// tFileWatch caches file content until the file is modified
+type tFileWatch struct {
+	sync.Mutex
+	modTime time.Time
+	size    int64
+	cred    Credential
+}
+
+// load returns cached credential unless the file is changed
+func (w *tFileWatch) load(path string, parse func(string) (Credential, error)) (Credential, error) {
+	w.Lock()
+	defer w.Unlock()
+
+	fi, err := os.Stat(path)
+	if err != nil {
+		return Credential{}, err
+	}
+
+	if !w.modTime.IsZero() && fi.ModTime().Equal(w.modTime) && fi.Size() == w.size {
+		return w.cred, nil
+	}
+
+	cred, err := parse(path)
+	if err != nil {
+		return Credential{}, err
+	}
+
+	w.modTime, w.size, w.cred = fi.ModTime(), fi.Size(), cred
+	return cred, nil
+}
+
+// FileProvider supplies credentials from toml file, it uses same format
+// as UseConfigFile. The file is re-read whenever it is modified.
+func FileProvider(path string) Provider {
+	watch := &tFileWatch{}
+
+	return ProviderFunc(func() (Credential, error) {
+		return watch.load(path, func(path string) (Credential, error) {
+			var file struct {
+				Auth Credential
+			}
+
+			if _, err := toml.DecodeFile(path, &file); err != nil {
+				return Credential{}, err
+			}
+
+			return file.Auth, nil
+		})
+	})
+}
+
+/*
+DirProvider supplies credentials from a directory which contains a file
+per secret, e.g. Kubernetes secret volume. File names are the config file
+keys: api_client_id, api_client_secret, oauth_client_id and
+oauth_client_secret. Missing files are ignored. Files are re-read whenever
+the secret is updated.
+*/
+func DirProvider(dir string) Provider {
+	watch := map[string]*tFileWatch{}
+	for _, key := range credentialKeys {
+		watch[key] = &tFileWatch{}
+	}
+
+	return ProviderFunc(func() (Credential, error) {
+		var cred Credential
+
+		for _, key := range credentialKeys {
+			c, err := watch[key].load(filepath.Join(dir, key), func(path string) (Credential, error) {
+				var c Credential
+
+				data, err := os.ReadFile(path)
+				if err != nil {
+					return c, err
+				}
+
+				c.set(key, strings.TrimSpace(string(data)))
+				return c, nil
+			})
+
+			switch {
+			case errors.Is(err, os.ErrNotExist):
+				continue
+			case err != nil:
+				return Credential{}, err
+			}
+
+			cred = cred.merge(c)
+		}
+
+		return cred, nil
+	})
+}
+
+// Keyring is a secret storage of operating system, e.g. macOS Keychain,
+// Windows Credential Manager or Secret Service on Linux. The SDK does not
+// depend on any keyring library, applications provide an adapter.
+type Keyring interface {
+	// Get returns secret stored for the service and the key.
+	Get(service, key string) (string, error)
+}
+
+// KeyringProvider supplies credentials from keyring. Secrets are looked up
+// by service name and config file keys, e.g. api_client_secret. Secrets
+// missing from the keyring are ignored.
+func KeyringProvider(keyring Keyring, service string) Provider {
+	return ProviderFunc(func() (Credential, error) {
+		var (
+			cred Credential
+			errs []error
+		)
+
+		for _, key := range credentialKeys {
+			val, err := keyring.Get(service, key)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("keyring %s/%s: %w", service, key, err))
+				continue
+			}
+			cred.set(key, val)
+		}
+
+		if cred == (Credential{}) && len(errs) > 0 {
+			return cred, errors.Join(errs...)
+		}
+
+		return cred, nil
+	})
+}
+
+/*
+CommandProvider supplies credentials from the output of external command,
+similarly to git credential helpers. The command shall print key=value
+lines using the config file keys
+
+	api_client_id=00000000-0000-0000-0000-000000000000
+	api_client_secret=some-random-base64
+
+The command is executed every time authorizer queries credentials.
+*/
+func CommandProvider(name string, args ...string) Provider {
+	return ProviderFunc(func() (Credential, error) {
+		var cred Credential
+		var stderr bytes.Buffer
+
+		cmd := exec.Command(name, args...)
+		cmd.Stderr = &stderr
+
+		out, err := cmd.Output()
+		if err != nil {
+			return cred, fmt.Errorf("credential command %s: %w: %s",
+				name, err, strings.TrimSpace(stderr.String()))
+		}
+
+		scanner := bufio.NewScanner(bytes.NewReader(out))
+		for scanner.Scan() {
+			key, val, ok := strings.Cut(scanner.Text(), "=")
+			if !ok {
+				continue
+			}
+			cred.set(strings.TrimSpace(key), strings.TrimSpace(val))
+		}
+
+		return cred, scanner.Err()
+	})
+}
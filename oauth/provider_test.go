@@ -0,0 +1,216 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package oauth
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/SSHcom/privx-sdk-go/v2/restapi"
+)
+
+type mockKeyring map[string]string
+
+func (k mockKeyring) Get(service, key string) (string, error) {
+	if val, ok := k[service+"/"+key]; ok {
+		return val, nil
+	}
+	return "", errors.New("not found")
+}
+
+func TestChainProvider(t *testing.T) {
+	chain := ChainProvider(
+		StaticProvider(Credential{Access: "access"}),
+		ProviderFunc(func() (Credential, error) {
+			return Credential{}, errors.New("unavailable")
+		}),
+		KeyringProvider(mockKeyring{"privx/api_client_secret": "secret"}, "privx"),
+		StaticProvider(Credential{Access: "ignored", OAuthClientID: "privx-external"}),
+	)
+
+	cred, err := chain.Retrieve()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := Credential{Access: "access", Secret: "secret", OAuthClientID: "privx-external"}
+	if cred != expect {
+		t.Errorf("unexpected credential: %+v", cred)
+	}
+}
+
+func TestChainProviderFails(t *testing.T) {
+	chain := ChainProvider(
+		StaticProvider(Credential{Access: "access"}),
+		KeyringProvider(mockKeyring{}, "privx"),
+	)
+
+	if _, err := chain.Retrieve(); err == nil {
+		t.Error("chain is not failing")
+	}
+}
+
+func TestDirProvider(t *testing.T) {
+	dir := t.TempDir()
+	write := func(key, val string, mod time.Time) {
+		path := filepath.Join(dir, key)
+		if err := os.WriteFile(path, []byte(val+"\n"), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, mod, mod); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	now := time.Now()
+	write("api_client_id", "access", now)
+	write("api_client_secret", "secret", now)
+
+	provider := DirProvider(dir)
+	cred, err := provider.Retrieve()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cred.Access != "access" || cred.Secret != "secret" {
+		t.Errorf("unexpected credential: %+v", cred)
+	}
+
+	write("api_client_secret", "rotated", now.Add(time.Minute))
+	cred, err = provider.Retrieve()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cred.Secret != "rotated" {
+		t.Errorf("secret is not re-read: %+v", cred)
+	}
+}
+
+func TestCommandProvider(t *testing.T) {
+	provider := CommandProvider("sh", "-c",
+		"echo api_client_id=access; echo api_client_secret=secret")
+
+	cred, err := provider.Retrieve()
+	if err != nil {
+		t.Skipf("shell is not available: %v", err)
+	}
+	if cred.Access != "access" || cred.Secret != "secret" {
+		t.Errorf("unexpected credential: %+v", cred)
+	}
+}
+
+func TestProviderRotation(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if err := r.ParseForm(); err != nil || r.Form.Get("password") != "rotated" {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"error_code": "INVALID_CREDENTIALS"}`))
+				return
+			}
+			json.NewEncoder(w).Encode(AccessToken{AccessToken: "token", ExpiresIn: 60})
+		}),
+	)
+	defer ts.Close()
+
+	secrets := []string{"expired", "rotated"}
+	provider := ProviderFunc(func() (Credential, error) {
+		secret := secrets[0]
+		if len(secrets) > 1 {
+			secrets = secrets[1:]
+		}
+		return Credential{
+			Access:            "access",
+			Secret:            secret,
+			OAuthClientID:     "privx-external",
+			OAuthClientSecret: "digest",
+		}, nil
+	})
+
+	auth := WithClientID(restapi.New(restapi.BaseURL(ts.URL)), UseProvider(provider))
+
+	token, err := auth.AccessToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "Bearer token" {
+		t.Errorf("unexpected token: %s", token)
+	}
+}
+
+func TestWithProviderIsLazy(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			json.NewEncoder(w).Encode(AccessToken{AccessToken: "token", ExpiresIn: 60})
+		}),
+	)
+	defer ts.Close()
+
+	calls := 0
+	provider := ProviderFunc(func() (Credential, error) {
+		calls++
+		if calls == 1 {
+			return Credential{}, errors.New("unavailable")
+		}
+		return Credential{
+			Access:            "access",
+			Secret:            "secret",
+			OAuthClientID:     "privx-external",
+			OAuthClientSecret: "digest",
+		}, nil
+	})
+
+	auth := With(restapi.New(restapi.BaseURL(ts.URL)), UseProvider(provider))
+	if calls != 0 {
+		t.Fatalf("provider is queried %d times at construction", calls)
+	}
+
+	if _, err := auth.AccessToken(); err == nil || err.Error() != "unavailable" {
+		t.Errorf("provider error is not reported: %v", err)
+	}
+
+	token, err := auth.AccessToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "Bearer token" {
+		t.Errorf("unexpected token: %s", token)
+	}
+}
+
+func TestProviderClearsRemovedFields(t *testing.T) {
+	creds := []Credential{
+		{Access: "access", Secret: "secret", OAuthClientID: "privx-external", OAuthClientSecret: "digest"},
+		{Access: "rotated", Secret: "secret"},
+	}
+	provider := ProviderFunc(func() (Credential, error) {
+		cred := creds[0]
+		creds = creds[1:]
+		return cred, nil
+	})
+
+	auth := newAuth(nil, UseProvider(provider), AuthClientId("explicit"))
+
+	if _, err := auth.resolveCredential(false); err != nil {
+		t.Fatal(err)
+	}
+	if auth.digest == "" || auth.clientId != "privx-external" {
+		t.Fatalf("credential is not applied: %+v", auth)
+	}
+
+	changed, err := auth.resolveCredential(true)
+	if err != nil || !changed {
+		t.Fatalf("credential is not changed: %v", err)
+	}
+	if auth.access != "rotated" || auth.digest != "" || auth.clientId != "explicit" {
+		t.Errorf("removed fields are not cleared: access=%s digest=%s client=%s", auth.access, auth.digest, auth.clientId)
+	}
+}
@@ -0,0 +1,135 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package oauth
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/SSHcom/privx-sdk-go/v2/restapi"
+	"golang.org/x/oauth2"
+)
+
+// Expiry returns expiry time of the current access token.
+func (auth *tAuth) Expiry() time.Time {
+	auth.mu.Lock()
+	defer auth.mu.Unlock()
+
+	if auth.inflight != nil || auth.token == nil {
+		return time.Time{}
+	}
+	return auth.token.notAfter
+}
+
+// tTokenSource adapts authorizer to oauth2.TokenSource
+type tTokenSource struct{ restapi.Authorizer }
+
+/*
+TokenSource exposes authorizer as oauth2.TokenSource, e.g. for gRPC
+credentials or libraries built on golang.org/x/oauth2
+
+	ts := oauth.TokenSource(oauth.WithClientID(client, opts...))
+*/
+func TokenSource(auth restapi.Authorizer) oauth2.TokenSource {
+	return &tTokenSource{auth}
+}
+
+// Token returns access token of the authorizer.
+func (ts *tTokenSource) Token() (*oauth2.Token, error) {
+	bearer, err := ts.AccessToken()
+	if err != nil {
+		return nil, err
+	}
+
+	token := &oauth2.Token{TokenType: "Bearer", AccessToken: bearer}
+	if kind, access, ok := strings.Cut(bearer, " "); ok {
+		token.TokenType, token.AccessToken = kind, access
+	}
+
+	if exp, ok := ts.Authorizer.(interface{ Expiry() time.Time }); ok {
+		token.Expiry = exp.Expiry()
+	}
+	if token.Expiry.IsZero() {
+		token.Expiry = jwtExpiry(token.AccessToken)
+	}
+
+	return token, nil
+}
+
+// jwtExpiry returns expiry of JWT access token, zero time if the token is
+// not JWT or the claim is not defined
+func jwtExpiry(token string) time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}
+	}
+
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(claims.Exp, 0)
+}
+
+// tAuthTokenSource adapts oauth2.TokenSource to authorizer
+type tAuthTokenSource struct{ oauth2.TokenSource }
+
+// WithTokenSource uses oauth2.TokenSource to authenticate client. Tokens are
+// cached until they expire.
+func WithTokenSource(ts oauth2.TokenSource) restapi.Authorizer {
+	return &tAuthTokenSource{oauth2.ReuseTokenSource(nil, ts)}
+}
+
+func (auth *tAuthTokenSource) AccessToken() (string, error) {
+	token, err := auth.Token()
+	if err != nil {
+		return "", err
+	}
+	return token.Type() + " " + token.AccessToken, nil
+}
+
+// AccessTokenContext returns access token of the token source, the token
+// source does not support cancellation once it is called.
+func (auth *tAuthTokenSource) AccessTokenContext(ctx context.Context) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return auth.AccessToken()
+}
+
+func (auth *tAuthTokenSource) CookieJar() http.CookieJar {
+	return nil
+}
+
+// Deprecated: Use auth.CookieJar() instead.
+func (auth *tAuthTokenSource) Cookie() string {
+	return ""
+}
+
+// Close is no-op for token source authorizer.
+func (auth *tAuthTokenSource) Close() error {
+	return nil
+}
+
+// NewHTTPClient creates HTTP client which injects access token of the
+// authorizer to requests, e.g. to call services proxied by PrivX. The
+// context controls the base HTTP client, see oauth2.NewClient.
+func NewHTTPClient(ctx context.Context, auth restapi.Authorizer) *http.Client {
+	return oauth2.NewClient(ctx, TokenSource(auth))
+}
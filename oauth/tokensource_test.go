@@ -0,0 +1,68 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package oauth
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+func TestTokenSource(t *testing.T) {
+	claims := base64.RawURLEncoding.EncodeToString([]byte(`{"exp": 4102444800}`))
+	jwt := "e30." + claims + ".sig"
+
+	token, err := TokenSource(WithToken("Bearer " + jwt)).Token()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if token.AccessToken != jwt || token.TokenType != "Bearer" {
+		t.Errorf("unexpected token: %+v", token)
+	}
+	if !token.Expiry.Equal(time.Unix(4102444800, 0)) {
+		t.Errorf("unexpected expiry: %v", token.Expiry)
+	}
+}
+
+func TestWithTokenSource(t *testing.T) {
+	auth := WithTokenSource(oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "token"}))
+
+	token, err := auth.AccessToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "Bearer token" {
+		t.Errorf("unexpected token: %s", token)
+	}
+}
+
+func TestNewHTTPClient(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer token" {
+				w.WriteHeader(http.StatusUnauthorized)
+			}
+		}),
+	)
+	defer ts.Close()
+
+	resp, err := NewHTTPClient(context.Background(), WithToken("Bearer token")).Get(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("unexpected status: %s", resp.Status)
+	}
+}
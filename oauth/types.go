@@ -7,6 +7,7 @@
 package oauth
 
 import (
+	"context"
 	"net/http"
 	"net/http/cookiejar"
 	"sync"
@@ -31,6 +32,25 @@ type Token struct {
 	Scope    string `json:"scope"`
 }
 
+// ContextAuthorizer is an authorizer which acquires access token within
+// the context. Authorizers of the package implement the interface.
+type ContextAuthorizer interface {
+	restapi.Authorizer
+	AccessTokenContext(ctx context.Context) (string, error)
+}
+
+// authorizers of the package implement ContextAuthorizer
+var (
+	_ ContextAuthorizer = (*tAuthPassword)(nil)
+	_ ContextAuthorizer = (*tAuthCode)(nil)
+	_ ContextAuthorizer = (*tAuthClientCredentials)(nil)
+	_ ContextAuthorizer = (*tAuthTokenExchange)(nil)
+	_ ContextAuthorizer = (*tAuthExplicit)(nil)
+	_ ContextAuthorizer = (*tAuthTokenSource)(nil)
+	_ ContextAuthorizer = (*tAuthLazy)(nil)
+	_ ContextAuthorizer = (*tPoolAuth)(nil)
+)
+
 // isInvalid checks if token is valid
 func (token *AccessToken) isInvalid() bool {
 	return token == nil || time.Now().After(token.notAfter)
@@ -38,7 +58,7 @@ func (token *AccessToken) isInvalid() bool {
 
 // tAuth authorizer client
 type tAuth struct {
-	*sync.Cond
+	mu            sync.Mutex
 	access        string
 	secret        string
 	digest        string
@@ -49,12 +69,21 @@ type tAuth struct {
 	token         *AccessToken
 	useCookies    bool
 	cookieJar     http.CookieJar
-	pending       bool
+	inflight      *tGrant
+	provider      Provider
+	credential    *Credential
+	explicit      *tExplicit
+	tokenSource   func() (string, error)
+	closed        bool
+	done          context.Context
+	mfaCode       func() (string, error)
+	hooks         []func(Event)
+	grantTimeout  time.Duration
+	grantCtx      context.Context
 }
 
 func newAuth(client restapi.Connector, opts ...Option) *tAuth {
 	auth := &tAuth{
-		Cond:   sync.NewCond(new(sync.Mutex)),
 		client: client,
 	}
 
@@ -70,28 +99,175 @@ func newAuth(client restapi.Connector, opts ...Option) *tAuth {
 		auth.cookieJar = jar
 	}
 
+	auth.closeOnDone()
+
 	return auth
 }
 
-// synchronized closure execution in the context of authorizer
-func (auth *tAuth) synchronized(f func() error) (err error) {
-	auth.L.Lock()
-	for auth.pending {
-		auth.Wait()
+// tGrant is an in-flight grant, waiters receive outcome of the grant
+type tGrant struct {
+	done  chan struct{}
+	token *AccessToken
+	err   error
+}
+
+// synchronized closure execution in the context of authorizer. The closure
+// acquires a new access token if the current one is invalid. Concurrent
+// callers wait for the in-flight closure and receive its outcome. Waiting is
+// cancelled by the context or the grant timeout, the closure is completed in
+// background.
+func (auth *tAuth) synchronized(ctx context.Context, f func() error) (*AccessToken, error) {
+	auth.mu.Lock()
+	if auth.closed {
+		auth.mu.Unlock()
+		return nil, ErrClosed
+	}
+
+	call := auth.inflight
+	if call == nil {
+		if !auth.token.isInvalid() {
+			token := auth.token
+			auth.mu.Unlock()
+			return token, nil
+		}
+
+		call = &tGrant{done: make(chan struct{})}
+		auth.inflight = call
+		go auth.acquire(call, auth.token, f)
+	}
+	auth.mu.Unlock()
+
+	var timeout <-chan time.Time
+	if auth.grantTimeout > 0 {
+		timer := time.NewTimer(auth.grantTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	select {
+	case <-call.done:
+		return call.token, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-timeout:
+		return nil, ErrGrantTimeout
+	}
+}
+
+// acquire executes closure of in-flight grant and wakes up waiters
+func (auth *tAuth) acquire(call *tGrant, expired *AccessToken, f func() error) {
+	if expired != nil {
+		auth.emit(Event{Type: EventTokenExpired, Expiry: expired.notAfter})
+	}
+
+	ctx, cancel := auth.deadline()
+	auth.grantCtx = ctx
+	err := f()
+	auth.grantCtx = nil
+	cancel()
+
+	auth.mu.Lock()
+	if call.err = err; err == nil {
+		call.token = auth.token
 	}
-	defer auth.L.Unlock()
-	if auth.token.isInvalid() {
-		auth.pending = true
-		auth.L.Unlock()
+	auth.inflight = nil
+	auth.mu.Unlock()
 
-		err = f()
+	close(call.done)
+}
 
-		auth.L.Lock()
-		auth.pending = false
-		auth.Broadcast()
+// deadline returns context of grant requests, the context is bound by the
+// grant timeout
+func (auth *tAuth) deadline() (context.Context, context.CancelFunc) {
+	if auth.grantTimeout > 0 {
+		return context.WithTimeout(context.Background(), auth.grantTimeout)
 	}
+	return context.WithCancel(context.Background())
+}
 
-	return
+// tExplicit are credentials of authorizer defined by options, provider
+// credentials fall back to them
+type tExplicit struct {
+	access   string
+	secret   string
+	digest   string
+	clientId string
+}
+
+// resolveCredential queries credentials from provider and applies them to
+// authorizer. Cached credentials are used unless refresh is required.
+// Fields missing from the provider credentials fall back to the explicitly
+// defined ones, therefore removed secrets are cleared.
+// It returns true if credentials are changed.
+func (auth *tAuth) resolveCredential(refresh bool) (bool, error) {
+	auth.mu.Lock()
+	skip := auth.provider == nil || (auth.credential != nil && !refresh)
+	auth.mu.Unlock()
+	if skip {
+		return false, nil
+	}
+
+	cred, err := auth.provider.Retrieve()
+	if err != nil {
+		return false, err
+	}
+
+	auth.mu.Lock()
+	defer auth.mu.Unlock()
+
+	if auth.credential != nil && *auth.credential == cred {
+		return false, nil
+	}
+
+	if auth.explicit == nil {
+		auth.explicit = &tExplicit{
+			access:   auth.access,
+			secret:   auth.secret,
+			digest:   auth.digest,
+			clientId: auth.clientId,
+		}
+	}
+
+	explicit := auth.explicit
+	auth.credential = &cred
+	auth.access = orDefault(cred.Access, explicit.access)
+	auth.secret = orDefault(cred.Secret, explicit.secret)
+	auth.digest = explicit.digest
+	auth.clientId = orDefault(cred.OAuthClientID, explicit.clientId)
+	if cred.OAuthClientID != "" && cred.OAuthClientSecret != "" {
+		auth.digest = digestOf(cred.OAuthClientID, cred.OAuthClientSecret)
+	}
+
+	return true, nil
+}
+
+// orDefault returns value or default if value is not defined
+func orDefault(value, def string) string {
+	if value == "" {
+		return def
+	}
+	return value
+}
+
+// withCredential executes grant using credentials from provider. The grant
+// is repeated once with fresh credentials if it fails and the provider
+// supplies different credentials.
+func (auth *tAuth) withCredential(grant func() error) error {
+	if _, err := auth.resolveCredential(false); err != nil {
+		return err
+	}
+
+	err := grant()
+	if err == nil || auth.provider == nil {
+		return err
+	}
+
+	changed, cerr := auth.resolveCredential(true)
+	if cerr != nil || !changed {
+		return err
+	}
+
+	return grant()
 }
 
 // Deprecated: Use auth.CookieJar() instead
@@ -100,6 +276,8 @@ func (auth *tAuth) Cookie() string {
 }
 
 func (auth *tAuth) CookieJar() http.CookieJar {
+	auth.mu.Lock()
+	defer auth.mu.Unlock()
 	return auth.cookieJar
 }
 
@@ -126,6 +304,20 @@ type reqExchangeCode struct {
 	Token  string `json:"token"`
 }
 
+// resLogin is the response of login, it either contains the code or
+// requires MFA challenge
+type resLogin struct {
+	Code        string `json:"code"`
+	State       string `json:"state"`
+	MFARequired bool   `json:"mfa_required"`
+}
+
+// reqMFACode answers the MFA challenge of login
+type reqMFACode struct {
+	Token string `json:"token"`
+	Code  string `json:"totp_code"`
+}
+
 // reqAccessToken exchanges the code for access token
 type reqAccessToken struct {
 	tClientID
@@ -141,6 +333,12 @@ type reqRefreshToken struct {
 	RefreshToken string `json:"refresh_token"`
 }
 
+// reqAccessTokenClient requests access token using client credentials
+type reqAccessTokenClient struct {
+	GrantType string `json:"grant_type"`
+	Scope     string `json:"scope,omitempty"`
+}
+
 // reqAccessToken
 type reqAccessTokenPassword struct {
 	GrantType string `json:"grant_type"`
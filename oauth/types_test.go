@@ -0,0 +1,190 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/SSHcom/privx-sdk-go/v2/restapi"
+)
+
+func mockGrant(grants *atomic.Int32, handler func(w http.ResponseWriter)) *httptest.Server {
+	return httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			grants.Add(1)
+			handler(w)
+		}),
+	)
+}
+
+func newPasswordAuth(url string, opts ...Option) ContextAuthorizer {
+	opts = append([]Option{Access("access"), Secret("secret"), Digest("id", "secret")}, opts...)
+	return WithClientID(restapi.New(restapi.BaseURL(url)), opts...).(ContextAuthorizer)
+}
+
+func TestSynchronizedConcurrency(t *testing.T) {
+	var grants atomic.Int32
+	ts := mockGrant(&grants, func(w http.ResponseWriter) {
+		time.Sleep(50 * time.Millisecond)
+		json.NewEncoder(w).Encode(AccessToken{AccessToken: "token", ExpiresIn: 60})
+	})
+	defer ts.Close()
+
+	auth := newPasswordAuth(ts.URL)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				token, err := auth.AccessToken()
+				if err != nil || token != "Bearer token" {
+					t.Errorf("unexpected token: %s, %v", token, err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := grants.Load(); n != 1 {
+		t.Errorf("unexpected number of grants: %d", n)
+	}
+}
+
+func TestSynchronizedFailureWakesWaiters(t *testing.T) {
+	var grants atomic.Int32
+	ts := mockGrant(&grants, func(w http.ResponseWriter) {
+		time.Sleep(100 * time.Millisecond)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error_code": "INVALID_CREDENTIALS"}`))
+	})
+	defer ts.Close()
+
+	auth := newPasswordAuth(ts.URL)
+
+	var wg sync.WaitGroup
+	var failed atomic.Int32
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := auth.AccessToken(); err != nil {
+				failed.Add(1)
+			}
+		}()
+		// ensure all callers join the in-flight grant
+		if i == 0 {
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	wg.Wait()
+
+	if n := failed.Load(); n != 50 {
+		t.Errorf("unexpected number of failures: %d", n)
+	}
+	if n := grants.Load(); n != 1 {
+		t.Errorf("waiters retried the grant: %d", n)
+	}
+}
+
+func TestSynchronizedContext(t *testing.T) {
+	release := make(chan struct{})
+	var grants atomic.Int32
+	ts := mockGrant(&grants, func(w http.ResponseWriter) {
+		<-release
+		json.NewEncoder(w).Encode(AccessToken{AccessToken: "token", ExpiresIn: 60})
+	})
+	defer ts.Close()
+	defer close(release)
+
+	auth := newPasswordAuth(ts.URL)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := auth.AccessTokenContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestGrantTimeout(t *testing.T) {
+	release := make(chan struct{})
+	var grants atomic.Int32
+	ts := mockGrant(&grants, func(w http.ResponseWriter) {
+		// the first grant hangs
+		if grants.Load() == 1 {
+			<-release
+		}
+		json.NewEncoder(w).Encode(AccessToken{AccessToken: "token", ExpiresIn: 60})
+	})
+	defer ts.Close()
+	defer close(release)
+
+	auth := newPasswordAuth(ts.URL, GrantTimeout(50*time.Millisecond))
+
+	if _, err := auth.AccessToken(); !errors.Is(err, ErrGrantTimeout) {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// hung grant is cancelled and the next caller acquires a new token
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		token, err := auth.AccessToken()
+		if err == nil {
+			if token != "Bearer token" {
+				t.Errorf("unexpected token: %s", token)
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("grant is not completed: %v", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if n := grants.Load(); n != 2 {
+		t.Errorf("unexpected number of grants: %d", n)
+	}
+}
+
+func TestCloseGrantTimeout(t *testing.T) {
+	release := make(chan struct{})
+	var grants atomic.Int32
+	ts := mockGrant(&grants, func(w http.ResponseWriter) {
+		<-release
+	})
+	defer ts.Close()
+	defer close(release)
+
+	auth := newPasswordAuth(ts.URL, GrantTimeout(50*time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := auth.AccessTokenContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- auth.(io.Closer).Close() }()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("close is not bound by grant timeout")
+	}
+}
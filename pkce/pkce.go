@@ -6,13 +6,16 @@
 
 // Package pkce implements the RFC 7636: "Proof Key for Code Exchange
 // by OAuth Public Clients". This implementation support only the S256
-// method.
+// method. ChallengeStore implements the server side of the protocol.
 package pkce
 
 import (
 	"crypto/rand"
 	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/base64"
+	"errors"
+	"fmt"
 )
 
 const (
@@ -27,6 +30,19 @@ const (
 	// ParamCodeVerifier defines the "code_verifier" form parameter
 	// name.
 	ParamCodeVerifier = "code_verifier"
+
+	// MinVerifierLength is the minimum length of code verifier.
+	MinVerifierLength = 43
+	// MaxVerifierLength is the maximum length of code verifier.
+	MaxVerifierLength = 128
+)
+
+var (
+	// ErrInvalidVerifier is returned for code verifiers which violate
+	// the RFC 7636 grammar.
+	ErrInvalidVerifier = errors.New("pkce: invalid code verifier")
+	// ErrUnsupportedMethod is returned for challenge methods other than S256.
+	ErrUnsupportedMethod = errors.New("pkce: unsupported challenge method")
 )
 
 // CodeVerifier defines a code verifier instance.
@@ -44,6 +60,64 @@ func NewCodeVerifier() (CodeVerifier, error) {
 	return CodeVerifier(base64.RawURLEncoding.EncodeToString(buf[:])), nil
 }
 
+// NewCodeVerifierLength creates a new random code verifier instance of
+// the given length, which must be within 43 and 128 characters.
+func NewCodeVerifierLength(length int) (CodeVerifier, error) {
+	if length < MinVerifierLength || length > MaxVerifierLength {
+		return "", fmt.Errorf("%w: length %d is out of range %d-%d",
+			ErrInvalidVerifier, length, MinVerifierLength, MaxVerifierLength)
+	}
+
+	// base64url alphabet is a subset of unreserved characters
+	buf := make([]byte, base64.RawURLEncoding.DecodedLen(length)+1)
+
+	_, err := rand.Read(buf)
+	if err != nil {
+		return "", err
+	}
+
+	return CodeVerifier(base64.RawURLEncoding.EncodeToString(buf)[:length]), nil
+}
+
+// ParseCodeVerifier validates externally supplied code verifier.
+func ParseCodeVerifier(verifier string) (CodeVerifier, error) {
+	v := CodeVerifier(verifier)
+	if err := v.Validate(); err != nil {
+		return "", err
+	}
+	return v, nil
+}
+
+// Validate checks the code verifier against RFC 7636 grammar
+//
+//	code-verifier = 43*128unreserved
+//	unreserved = ALPHA / DIGIT / "-" / "." / "_" / "~"
+func (v CodeVerifier) Validate() error {
+	if len(v) < MinVerifierLength || len(v) > MaxVerifierLength {
+		return fmt.Errorf("%w: length %d is out of range %d-%d",
+			ErrInvalidVerifier, len(v), MinVerifierLength, MaxVerifierLength)
+	}
+
+	for i := 0; i < len(v); i++ {
+		if !isUnreserved(v[i]) {
+			return fmt.Errorf("%w: invalid character at %d", ErrInvalidVerifier, i)
+		}
+	}
+
+	return nil
+}
+
+// isUnreserved checks if the character is unreserved, RFC 3986
+func isUnreserved(c byte) bool {
+	switch {
+	case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9':
+		return true
+	case c == '-', c == '.', c == '_', c == '~':
+		return true
+	}
+	return false
+}
+
 func (v CodeVerifier) String() string {
 	return string(v)
 }
@@ -55,11 +129,12 @@ func (v CodeVerifier) ChallengeS256() (string, string) {
 	return base64.RawURLEncoding.EncodeToString(digest[:]), MethodS256
 }
 
-// Verify verifies the challenge against the code verifier instance.
+// Verify verifies the challenge against the code verifier instance. The
+// challenge is compared in constant time.
 func (v CodeVerifier) Verify(challenge, method string) bool {
-	if method != MethodS256 {
+	if method != MethodS256 || v.Validate() != nil {
 		return false
 	}
 	computed, _ := v.ChallengeS256()
-	return computed == challenge
+	return subtle.ConstantTimeCompare([]byte(computed), []byte(challenge)) == 1
 }
@@ -7,8 +7,11 @@
 package pkce
 
 import (
+	"errors"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestPKCE(t *testing.T) {
@@ -47,3 +50,84 @@ func TestString(t *testing.T) {
 		t.Errorf("Expected type string, but got %v", result)
 	}
 }
+
+func TestNewCodeVerifierLength(t *testing.T) {
+	for _, length := range []int{MinVerifierLength, 64, MaxVerifierLength} {
+		verifier, err := NewCodeVerifierLength(length)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(verifier) != length {
+			t.Errorf("Expected length %d, but got %d", length, len(verifier))
+		}
+		if err := verifier.Validate(); err != nil {
+			t.Errorf("Expected valid verifier, but got %v", err)
+		}
+	}
+
+	for _, length := range []int{MinVerifierLength - 1, MaxVerifierLength + 1} {
+		if _, err := NewCodeVerifierLength(length); !errors.Is(err, ErrInvalidVerifier) {
+			t.Errorf("Expected ErrInvalidVerifier for length %d, but got %v", length, err)
+		}
+	}
+}
+
+func TestParseCodeVerifier(t *testing.T) {
+	// RFC 7636 Appendix B
+	verifier, err := ParseCodeVerifier("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	challenge, _ := verifier.ChallengeS256()
+	if challenge != "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM" {
+		t.Errorf("Unexpected challenge %s", challenge)
+	}
+
+	for _, invalid := range []string{
+		"short",
+		strings.Repeat("a", MaxVerifierLength+1),
+		strings.Repeat("a", MinVerifierLength-1) + "+",
+	} {
+		if _, err := ParseCodeVerifier(invalid); !errors.Is(err, ErrInvalidVerifier) {
+			t.Errorf("Expected ErrInvalidVerifier for %q, but got %v", invalid, err)
+		}
+	}
+}
+
+func TestChallengeStore(t *testing.T) {
+	verifier, err := NewCodeVerifier()
+	if err != nil {
+		t.Fatal(err)
+	}
+	challenge, method := verifier.ChallengeS256()
+
+	store := NewChallengeStore(time.Minute)
+	if err := store.Store("state", challenge, method); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.Verify("state", verifier.String()); err != nil {
+		t.Errorf("Expected verification to succeed, but got %v", err)
+	}
+
+	if err := store.Verify("state", verifier.String()); !errors.Is(err, ErrUnknownState) {
+		t.Errorf("Expected ErrUnknownState on reuse, but got %v", err)
+	}
+
+	other, _ := NewCodeVerifier()
+	store.Store("state", challenge, method)
+	if err := store.Verify("state", other.String()); !errors.Is(err, ErrVerificationFailed) {
+		t.Errorf("Expected ErrVerificationFailed, but got %v", err)
+	}
+
+	if err := store.Store("state", challenge, "plain"); !errors.Is(err, ErrUnsupportedMethod) {
+		t.Errorf("Expected ErrUnsupportedMethod, but got %v", err)
+	}
+
+	expired := NewChallengeStore(-time.Second)
+	expired.Store("state", challenge, method)
+	if err := expired.Verify("state", verifier.String()); !errors.Is(err, ErrChallengeExpired) {
+		t.Errorf("Expected ErrChallengeExpired, but got %v", err)
+	}
+}
@@ -0,0 +1,128 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package pkce
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrUnknownState is returned if no challenge is stored for the state.
+	ErrUnknownState = errors.New("pkce: unknown state")
+	// ErrChallengeExpired is returned if the challenge is expired.
+	ErrChallengeExpired = errors.New("pkce: challenge expired")
+	// ErrVerificationFailed is returned if the code verifier does not
+	// match the challenge.
+	ErrVerificationFailed = errors.New("pkce: verification failed")
+)
+
+// challengeS256Length is the length of base64url encoded SHA-256 digest
+const challengeS256Length = 43
+
+// tChallenge is a challenge stored by the authorization request
+type tChallenge struct {
+	challenge string
+	notAfter  time.Time
+}
+
+/*
+ChallengeStore implements server side of PKCE. The authorization endpoint
+stores the challenge keyed by state and the token endpoint verifies the
+code verifier of the code exchange. Challenges are removed once verified.
+
+	store := pkce.NewChallengeStore(5 * time.Minute)
+
+	// authorization request
+	err := store.Store(
+		r.FormValue("state"),
+		r.FormValue(pkce.ParamCodeChallenge),
+		r.FormValue(pkce.ParamCodeChallengeMethod),
+	)
+
+	// code exchange
+	err := store.Verify(state, r.FormValue(pkce.ParamCodeVerifier))
+*/
+type ChallengeStore struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	challenges map[string]tChallenge
+}
+
+// NewChallengeStore creates challenge store, challenges expire after ttl.
+func NewChallengeStore(ttl time.Duration) *ChallengeStore {
+	return &ChallengeStore{
+		ttl:        ttl,
+		challenges: map[string]tChallenge{},
+	}
+}
+
+// Store saves the challenge of authorization request.
+func (s *ChallengeStore) Store(state, challenge, method string) error {
+	if method != MethodS256 {
+		return fmt.Errorf("%w: %q", ErrUnsupportedMethod, method)
+	}
+
+	if len(challenge) != challengeS256Length {
+		return fmt.Errorf("pkce: invalid challenge length %d", len(challenge))
+	}
+
+	if state == "" {
+		return ErrUnknownState
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.expire()
+	s.challenges[state] = tChallenge{
+		challenge: challenge,
+		notAfter:  time.Now().Add(s.ttl),
+	}
+
+	return nil
+}
+
+// Verify checks the code verifier of code exchange against the challenge
+// stored for the state. The challenge is removed, it cannot be reused.
+func (s *ChallengeStore) Verify(state, verifier string) error {
+	s.mu.Lock()
+	c, ok := s.challenges[state]
+	delete(s.challenges, state)
+	s.mu.Unlock()
+
+	if !ok {
+		return ErrUnknownState
+	}
+
+	if time.Now().After(c.notAfter) {
+		return ErrChallengeExpired
+	}
+
+	v, err := ParseCodeVerifier(verifier)
+	if err != nil {
+		return err
+	}
+
+	if !v.Verify(c.challenge, MethodS256) {
+		return ErrVerificationFailed
+	}
+
+	return nil
+}
+
+// expire removes expired challenges
+func (s *ChallengeStore) expire() {
+	now := time.Now()
+	for state, c := range s.challenges {
+		if now.After(c.notAfter) {
+			delete(s.challenges, state)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+/*
+Package privx bundles clients of all PrivX services behind a single facade.
+Service clients are constructed lazily and share the HTTP transport and the
+authorizer.
+
+	client := privx.New(
+		privx.UseConfigFile("config.toml"),
+		privx.UseEnvironment(),
+	)
+	defer client.Close()
+
+	hosts, err := client.Hosts().GetHosts()
+*/
+package privx
+
+import (
+	"io"
+	"sync"
+
+	"github.com/SSHcom/privx-sdk-go/v2/api/apiproxy"
+	"github.com/SSHcom/privx-sdk-go/v2/api/auth"
+	"github.com/SSHcom/privx-sdk-go/v2/api/authorizer"
+	"github.com/SSHcom/privx-sdk-go/v2/api/connectionmanager"
+	"github.com/SSHcom/privx-sdk-go/v2/api/dbproxy"
+	"github.com/SSHcom/privx-sdk-go/v2/api/hoststore"
+	"github.com/SSHcom/privx-sdk-go/v2/api/licensemanager"
+	"github.com/SSHcom/privx-sdk-go/v2/api/monitor"
+	"github.com/SSHcom/privx-sdk-go/v2/api/networkaccessmanager"
+	"github.com/SSHcom/privx-sdk-go/v2/api/rolestore"
+	"github.com/SSHcom/privx-sdk-go/v2/api/secretsmanager"
+	"github.com/SSHcom/privx-sdk-go/v2/api/settings"
+	"github.com/SSHcom/privx-sdk-go/v2/api/trailindex"
+	"github.com/SSHcom/privx-sdk-go/v2/api/userstore"
+	"github.com/SSHcom/privx-sdk-go/v2/api/vault"
+	"github.com/SSHcom/privx-sdk-go/v2/api/workflow"
+	"github.com/SSHcom/privx-sdk-go/v2/oauth"
+	"github.com/SSHcom/privx-sdk-go/v2/restapi"
+)
+
+// lazy constructs value once on first use
+type lazy[T any] struct {
+	once  sync.Once
+	value T
+}
+
+func (l *lazy[T]) get(f func() T) T {
+	l.once.Do(func() { l.value = f() })
+	return l.value
+}
+
+// Client is a facade of PrivX service clients.
+type Client struct {
+	api  restapi.Connector
+	auth restapi.Authorizer
+
+	apiProxy             lazy[*apiproxy.ApiProxy]
+	authService          lazy[*auth.Auth]
+	authorizer           lazy[*authorizer.Authorizer]
+	connectionManager    lazy[*connectionmanager.ConnectionManager]
+	dbProxy              lazy[*dbproxy.DbProxy]
+	hostStore            lazy[*hoststore.HostStore]
+	licenseManager       lazy[*licensemanager.LicenseManager]
+	monitor              lazy[*monitor.Monitor]
+	networkAccessManager lazy[*networkaccessmanager.NetworkAccessManager]
+	roleStore            lazy[*rolestore.RoleStore]
+	secretsManager       lazy[*secretsmanager.SecretsManager]
+	settings             lazy[*settings.Settings]
+	trailIndex           lazy[*trailindex.TrailIndex]
+	userStore            lazy[*userstore.UserStore]
+	vault                lazy[*vault.Vault]
+	workflow             lazy[*workflow.WorkflowEngine]
+}
+
+// New creates PrivX client. The HTTP transport is configured by restapi
+// options and the authorizer by oauth options, see oauth.With(...), unless
+// the authorizer is defined explicitly.
+func New(opts ...Option) *Client {
+	conf := &tConfig{}
+	for _, opt := range opts {
+		conf = opt(conf)
+	}
+
+	curl := restapi.New(conf.api...)
+
+	authorizer := conf.auth
+	if authorizer == nil {
+		authorizer = oauth.With(curl, conf.oauth...)
+	}
+
+	return &Client{
+		api:  restapi.Derive(curl, restapi.Auth(authorizer)),
+		auth: authorizer,
+	}
+}
+
+// Connector returns authorized HTTP connector shared by service clients.
+func (c *Client) Connector() restapi.Connector {
+	return c.api
+}
+
+// OAuth returns the authorizer shared by service clients.
+func (c *Client) OAuth() restapi.Authorizer {
+	return c.auth
+}
+
+// Close terminates the session of the authorizer, see oauth Close.
+func (c *Client) Close() error {
+	if closer, ok := c.auth.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// ApiProxy returns api proxy client.
+func (c *Client) ApiProxy() *apiproxy.ApiProxy {
+	return c.apiProxy.get(func() *apiproxy.ApiProxy { return apiproxy.New(c.api) })
+}
+
+// Auth returns auth client.
+func (c *Client) Auth() *auth.Auth {
+	return c.authService.get(func() *auth.Auth { return auth.New(c.api) })
+}
+
+// Authorizer returns authorizer client.
+func (c *Client) Authorizer() *authorizer.Authorizer {
+	return c.authorizer.get(func() *authorizer.Authorizer { return authorizer.New(c.api) })
+}
+
+// Connections returns connection manager client.
+func (c *Client) Connections() *connectionmanager.ConnectionManager {
+	return c.connectionManager.get(func() *connectionmanager.ConnectionManager { return connectionmanager.New(c.api) })
+}
+
+// DbProxy returns db proxy client.
+func (c *Client) DbProxy() *dbproxy.DbProxy {
+	return c.dbProxy.get(func() *dbproxy.DbProxy { return dbproxy.New(c.api) })
+}
+
+// Hosts returns host store client.
+func (c *Client) Hosts() *hoststore.HostStore {
+	return c.hostStore.get(func() *hoststore.HostStore { return hoststore.New(c.api) })
+}
+
+// Licenses returns license manager client.
+func (c *Client) Licenses() *licensemanager.LicenseManager {
+	return c.licenseManager.get(func() *licensemanager.LicenseManager { return licensemanager.New(c.api) })
+}
+
+// Monitor returns monitor client.
+func (c *Client) Monitor() *monitor.Monitor {
+	return c.monitor.get(func() *monitor.Monitor { return monitor.New(c.api) })
+}
+
+// NetworkAccess returns network access manager client.
+func (c *Client) NetworkAccess() *networkaccessmanager.NetworkAccessManager {
+	return c.networkAccessManager.get(func() *networkaccessmanager.NetworkAccessManager { return networkaccessmanager.New(c.api) })
+}
+
+// Roles returns role store client.
+func (c *Client) Roles() *rolestore.RoleStore {
+	return c.roleStore.get(func() *rolestore.RoleStore { return rolestore.New(c.api) })
+}
+
+// Secrets returns secrets manager client.
+func (c *Client) Secrets() *secretsmanager.SecretsManager {
+	return c.secretsManager.get(func() *secretsmanager.SecretsManager { return secretsmanager.New(c.api) })
+}
+
+// Settings returns settings client.
+func (c *Client) Settings() *settings.Settings {
+	return c.settings.get(func() *settings.Settings { return settings.New(c.api) })
+}
+
+// Trails returns trail index client.
+func (c *Client) Trails() *trailindex.TrailIndex {
+	return c.trailIndex.get(func() *trailindex.TrailIndex { return trailindex.New(c.api) })
+}
+
+// Users returns user store client.
+func (c *Client) Users() *userstore.UserStore {
+	return c.userStore.get(func() *userstore.UserStore { return userstore.New(c.api) })
+}
+
+// Vault returns vault client.
+func (c *Client) Vault() *vault.Vault {
+	return c.vault.get(func() *vault.Vault { return vault.New(c.api) })
+}
+
+// Workflow returns workflow engine client.
+func (c *Client) Workflow() *workflow.WorkflowEngine {
+	return c.workflow.get(func() *workflow.WorkflowEngine { return workflow.New(c.api) })
+}
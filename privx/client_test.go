@@ -0,0 +1,47 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package privx_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SSHcom/privx-sdk-go/v2/oauth"
+	"github.com/SSHcom/privx-sdk-go/v2/privx"
+)
+
+func TestClient(t *testing.T) {
+	ts := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Write([]byte(`{"status": "ok"}`))
+		}),
+	)
+	defer ts.Close()
+
+	client := privx.New(
+		privx.BaseURL(ts.URL),
+		privx.Authorizer(oauth.WithToken("Bearer token")),
+	)
+	defer client.Close()
+
+	if client.Hosts() != client.Hosts() {
+		t.Error("service client is not shared")
+	}
+
+	status, err := client.Hosts().Status()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Status != "ok" {
+		t.Errorf("unexpected status: %+v", status)
+	}
+}
@@ -0,0 +1,71 @@
+//
+// Copyright (c) 2026 SSH Communications Security Inc.
+//
+// All rights reserved.
+//
+
+package privx
+
+import (
+	"github.com/SSHcom/privx-sdk-go/v2/oauth"
+	"github.com/SSHcom/privx-sdk-go/v2/restapi"
+)
+
+// tConfig collects configuration of HTTP transport and authorizer
+type tConfig struct {
+	api   []restapi.Option
+	oauth []oauth.Option
+	auth  restapi.Authorizer
+}
+
+// Option is configuration applied to the client
+type Option func(*tConfig) *tConfig
+
+// UseConfigFile setups HTTP transport and authorizer from toml file,
+// see restapi.UseConfigFile and oauth.UseConfigFile.
+func UseConfigFile(path string) Option {
+	return func(conf *tConfig) *tConfig {
+		conf.api = append(conf.api, restapi.UseConfigFile(path))
+		conf.oauth = append(conf.oauth, oauth.UseConfigFile(path))
+		return conf
+	}
+}
+
+// UseEnvironment setups HTTP transport and authorizer using environment
+// variables, see restapi.UseEnvironment and oauth.UseEnvironment.
+func UseEnvironment() Option {
+	return func(conf *tConfig) *tConfig {
+		conf.api = append(conf.api, restapi.UseEnvironment())
+		conf.oauth = append(conf.oauth, oauth.UseEnvironment())
+		return conf
+	}
+}
+
+// BaseURL defines a target PrivX server, see restapi.BaseURL.
+func BaseURL(endpoint string) Option {
+	return API(restapi.BaseURL(endpoint))
+}
+
+// API setups HTTP transport options.
+func API(opts ...restapi.Option) Option {
+	return func(conf *tConfig) *tConfig {
+		conf.api = append(conf.api, opts...)
+		return conf
+	}
+}
+
+// OAuth setups authorizer options.
+func OAuth(opts ...oauth.Option) Option {
+	return func(conf *tConfig) *tConfig {
+		conf.oauth = append(conf.oauth, opts...)
+		return conf
+	}
+}
+
+// Authorizer uses explicitly defined authorizer instead of oauth.With(...).
+func Authorizer(auth restapi.Authorizer) Option {
+	return func(conf *tConfig) *tConfig {
+		conf.auth = auth
+		return conf
+	}
+}
@@ -8,6 +8,7 @@ package restapi
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -59,6 +60,33 @@ func New(opts ...Option) Connector {
 	return client
 }
 
+// Derive creates an instance of HTTP client which shares HTTP transport and
+// configuration with the parent client. Options are applied to the derived
+// client only, e.g. restapi.Auth(...) binds another authorizer to the client.
+// Options which configure transport, e.g. TrustAnchor, shall be applied to
+// the parent. The parent shall be created by restapi.New, otherwise the
+// derived client is created from options only.
+func Derive(parent Connector, opts ...Option) Connector {
+	p, ok := parent.(*tClient)
+	if !ok {
+		return New(opts...)
+	}
+
+	client := &tClient{
+		auth:    p.auth,
+		baseURL: p.baseURL,
+		verbose: p.verbose,
+		retry:   p.retry,
+		http:    p.http,
+	}
+
+	for _, opt := range opts {
+		client = opt(client)
+	}
+
+	return client
+}
+
 func (client *tClient) doWithRetry(req *http.Request) (*http.Response, error) {
 	for i := 0; i < client.retry; i++ {
 		in, err := client.do(req)
@@ -78,7 +106,13 @@ func (client *tClient) doWithRetry(req *http.Request) (*http.Response, error) {
 
 func (client *tClient) do(req *http.Request) (*http.Response, error) {
 	if client.auth != nil {
-		token, err := client.auth.AccessToken()
+		var token string
+		var err error
+		if ca, ok := client.auth.(ContextAuthorizer); ok {
+			token, err = ca.AccessTokenContext(req.Context())
+		} else {
+			token, err = client.auth.AccessToken()
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -124,6 +158,7 @@ type tCURL struct {
 	output    *http.Response
 	fail      error
 	cookieJar http.CookieJar
+	ctx       context.Context
 }
 
 // Query defines URI parameters of the request
@@ -190,6 +225,20 @@ func (curl *tCURL) CookieJar(jar http.CookieJar) CURL {
 	return curl
 }
 
+// Context sets context of request
+func (curl *tCURL) Context(ctx context.Context) CURL {
+	curl.ctx = ctx
+	return curl
+}
+
+// context returns context of request, background context by default
+func (curl *tCURL) context() context.Context {
+	if curl.ctx == nil {
+		return context.Background()
+	}
+	return curl.ctx
+}
+
 // Status payload from target URL and discards it.
 func (curl *tCURL) Status(status ...int) (http.Header, error) {
 	curl.method = http.MethodGet
@@ -288,7 +337,7 @@ func writeToFile(filename string, resp *http.Response) error {
 func (curl *tCURL) Download(filename string) error {
 	curl.method = http.MethodGet
 
-	req, err := http.NewRequest(curl.method, curl.url, curl.payload)
+	req, err := http.NewRequestWithContext(curl.context(), curl.method, curl.url, curl.payload)
 	if err != nil {
 		return err
 	}
@@ -443,7 +492,7 @@ func (curl *tCURL) unsafeIO() *tCURL {
 		return curl
 	}
 
-	req, err := http.NewRequest(curl.method, curl.url, curl.payload)
+	req, err := http.NewRequestWithContext(curl.context(), curl.method, curl.url, curl.payload)
 	if curl.fail = err; err != nil {
 		return curl
 	}
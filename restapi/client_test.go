@@ -7,13 +7,17 @@
 package restapi_test
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/SSHcom/privx-sdk-go/v2/oauth"
 	"github.com/SSHcom/privx-sdk-go/v2/restapi"
 )
 
@@ -106,6 +110,32 @@ func TestRecvNoIdP(t *testing.T) {
 	}
 }
 
+type trusted struct{}
+
+func (trusted) AccessToken() (string, error) { return "Bearer trusted", nil }
+func (trusted) Cookie() string               { return "" }
+
+func TestDerive(t *testing.T) {
+	ts := mock()
+	defer ts.Close()
+
+	var data struct {
+		ID string `json:"id"`
+	}
+
+	parent := restapi.New(restapi.BaseURL(ts.URL))
+	_, err := restapi.Derive(parent, restapi.Auth(trusted{})).
+		URL("/").Get(&data)
+
+	if err != nil {
+		t.Errorf("client fails: %v", err)
+	}
+
+	if data.ID != "trusted" {
+		t.Errorf("unexpected response: %v", data)
+	}
+}
+
 func mock() *httptest.Server {
 	return httptest.NewServer(
 		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -143,3 +173,41 @@ func mockStatus() *httptest.Server {
 		}),
 	)
 }
+
+func TestContextGrant(t *testing.T) {
+	release := make(chan struct{})
+	grant := httptest.NewServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+		}),
+	)
+	defer grant.Close()
+	defer close(release)
+
+	ts := mock()
+	defer ts.Close()
+
+	auth := oauth.WithClientID(
+		restapi.New(restapi.BaseURL(grant.URL)),
+		oauth.Access("access"), oauth.Secret("secret"), oauth.Digest("id", "secret"),
+	)
+	client := restapi.New(restapi.BaseURL(ts.URL), restapi.Auth(auth))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := client.URL("/").Context(ctx).Get(&struct{}{})
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("unexpected error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("request is not bounded by context while acquiring token")
+	}
+}
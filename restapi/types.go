@@ -7,6 +7,7 @@
 package restapi
 
 import (
+	"context"
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
@@ -27,6 +28,9 @@ type CURL interface {
 	Header(string, string) CURL
 	// CookieJar sets the cookie jar for the request
 	CookieJar(jar http.CookieJar) CURL
+	// Context sets the context of the request, the request is cancelled
+	// once the context is done
+	Context(ctx context.Context) CURL
 	// Status evalutes the request
 	Status(...int) (http.Header, error)
 	Get(interface{}) (http.Header, error)
@@ -44,6 +48,12 @@ type Authorizer interface {
 	Cookie() string
 }
 
+// ContextAuthorizer extends the Authorizer interface with a capability to
+// acquire access token within the context of the request
+type ContextAuthorizer interface {
+	AccessTokenContext(ctx context.Context) (string, error)
+}
+
 // CookieJarProvider extends the Authorizer interface with a capability to
 // return a cookie jar used in making the requests
 type CookieJarProvider interface {